@@ -2,7 +2,10 @@ module h3terraform
 
 go 1.25.6
 
-require github.com/hashicorp/terraform-plugin-framework v1.16.1
+require (
+	github.com/hashicorp/terraform-plugin-framework v1.16.1
+	github.com/hashicorp/terraform-plugin-framework-timeouts v0.4.1
+)
 
 require (
 	github.com/fatih/color v1.15.0 // indirect
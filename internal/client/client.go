@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"sort"
 	"strings"
 	"time"
@@ -22,6 +23,8 @@ type Client struct {
 	secretKey  string
 	httpClient *http.Client
 	maxRetries int
+
+	defaultLabels map[string]string
 }
 
 // Config - конфигурация клиента
@@ -31,6 +34,9 @@ type Config struct {
 	SecretKey  string
 	Timeout    time.Duration
 	MaxRetries int
+
+	// DefaultLabels - labels, добавляемые ко всем ресурсам провайдера
+	DefaultLabels map[string]string
 }
 
 // NewClient создает HTTP клиент с HMAC аутентификацией
@@ -56,10 +62,19 @@ func NewClient(cfg Config) (*Client, error) {
 		httpClient: &http.Client{
 			Timeout: cfg.Timeout,
 		},
-		maxRetries: cfg.MaxRetries,
+		maxRetries:    cfg.MaxRetries,
+		defaultLabels: cfg.DefaultLabels,
 	}, nil
 }
 
+// DefaultLabels возвращает default_labels провайдера
+func (c *Client) DefaultLabels() map[string]string {
+	if c == nil {
+		return nil
+	}
+	return c.defaultLabels
+}
+
 // Do выполняет HTTP запрос с HMAC подписью
 func (c *Client) Do(ctx context.Context, method, path string, queryParams map[string]string, body interface{}, result interface{}) error {
 	var bodyBytes []byte
@@ -73,19 +88,18 @@ func (c *Client) Do(ctx context.Context, method, path string, queryParams map[st
 	}
 
 	// Формируем URL
-	url := c.baseURL + path
+	reqURL := c.baseURL + path
 
-	// Добавляем query parameters
+	// Добавляем query parameters (экранируются; Encode сортирует по ключу - ОБЯЗАТЕЛЬНО для подписи)
 	if len(queryParams) > 0 {
-		var queryParts []string
+		query := url.Values{}
 		for k, v := range queryParams {
-			queryParts = append(queryParts, k+"="+v)
+			query.Set(k, v)
 		}
-		sort.Strings(queryParts) // ОБЯЗАТЕЛЬНАЯ сортировка
-		url += "?" + strings.Join(queryParts, "&")
+		reqURL += "?" + query.Encode()
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyBytes))
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewReader(bodyBytes))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -131,7 +145,7 @@ func (c *Client) Do(ctx context.Context, method, path string, queryParams map[st
 			return &HTTPError{
 				StatusCode: resp.StatusCode,
 				Method:     method,
-				URL:        url,
+				URL:        reqURL,
 				Body:       string(respBody),
 			}
 		}
@@ -0,0 +1,86 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Filter - фильтр для plural data sources (по имени, статусу и labels)
+type Filter struct {
+	NameRegex *regexp.Regexp
+	Status    string
+	Labels    map[string]string
+}
+
+// New собирает фильтр из атрибутов name_regex, status и labels
+func New(ctx context.Context, nameRegex, status types.String, labels types.Map) (*Filter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	f := &Filter{}
+
+	if !nameRegex.IsNull() && nameRegex.ValueString() != "" {
+		re, err := regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Invalid name_regex",
+				fmt.Sprintf("Could not compile %q: %s", nameRegex.ValueString(), err.Error()),
+			)
+			return nil, diags
+		}
+		f.NameRegex = re
+	}
+
+	if !status.IsNull() {
+		f.Status = status.ValueString()
+	}
+
+	if !labels.IsNull() {
+		diags.Append(labels.ElementsAs(ctx, &f.Labels, false)...)
+	}
+
+	return f, diags
+}
+
+// Match проверяет, подходит ли объект под фильтр (статус сравнивается без учета регистра)
+func (f *Filter) Match(name, status string, labels map[string]string) bool {
+	if f.NameRegex != nil && !f.NameRegex.MatchString(name) {
+		return false
+	}
+	if f.Status != "" && !strings.EqualFold(f.Status, status) {
+		return false
+	}
+	// Отсутствующий label не совпадает даже с пустым значением
+	for k, v := range f.Labels {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Attributes - общие атрибуты фильтра для схемы plural data source
+func Attributes(withStatus bool) map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: "Regular expression the name must match",
+			Optional:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels that must all be present with the given values",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+	if withStatus {
+		attrs["status"] = schema.StringAttribute{
+			MarkdownDescription: "Status to match (case-insensitive)",
+			Optional:            true,
+		}
+	}
+	return attrs
+}
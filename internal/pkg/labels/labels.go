@@ -0,0 +1,122 @@
+package labels
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Attribute - схема атрибута labels, задаваемого пользователем
+func Attribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Labels (key/value pairs) attached to the resource",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+}
+
+// AllAttribute - схема атрибута labels_all (labels + default_labels провайдера)
+func AllAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "All labels of the resource, including provider `default_labels`",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+}
+
+// Merge объединяет default_labels провайдера с labels ресурса (labels ресурса приоритетнее)
+func Merge(defaults, labels map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(labels))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return merged
+}
+
+// Value конвертирует map в types.Map (nil превращается в пустую map)
+func Value(m map[string]string) types.Map {
+	elems := make(map[string]attr.Value, len(m))
+	for k, v := range m {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// ToMap конвертирует types.Map в map[string]string (null/unknown дают nil,
+// известная пустая map - пустую, чтобы PATCH мог очистить labels)
+func ToMap(ctx context.Context, m types.Map) (map[string]string, diag.Diagnostics) {
+	if m.IsNull() || m.IsUnknown() {
+		return nil, nil
+	}
+	result := make(map[string]string, len(m.Elements()))
+	diags := m.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// FromAPI восстанавливает labels пользователя из labels, которые вернул API:
+// ключи из default_labels отбрасываются, если пользователь не задавал их явно
+func FromAPI(ctx context.Context, api, defaults map[string]string, current types.Map) (types.Map, diag.Diagnostics) {
+	configured, diags := ToMap(ctx, current)
+	if diags.HasError() {
+		return current, diags
+	}
+
+	result := make(map[string]string)
+	for k, v := range api {
+		if dv, ok := defaults[k]; ok && dv == v {
+			if _, explicit := configured[k]; !explicit {
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	if len(result) == 0 && current.IsNull() {
+		return types.MapNull(types.StringType), diags
+	}
+	return Value(result), diags
+}
+
+// ModifyPlan вычисляет labels_all в плане, чтобы слияние с default_labels было видно в plan
+func ModifyPlan(ctx context.Context, defaults map[string]string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planned.IsUnknown() || hasUnknownElements(planned) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	configured, diags := ToMap(ctx, planned)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), Value(Merge(defaults, configured)))...)
+}
+
+func hasUnknownElements(m types.Map) bool {
+	for _, v := range m.Elements() {
+		if v.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
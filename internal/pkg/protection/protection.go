@@ -0,0 +1,44 @@
+package protection
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Attribute - схема атрибута deletion_protection
+func Attribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Refuse to destroy the resource while true (default: false). Synced with the server-side protection flag",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+// FromAPI возвращает deletion_protection из ответа API
+// (если API не сообщает флаг, оставляем текущее значение)
+func FromAPI(api *bool, current types.Bool) types.Bool {
+	if api != nil {
+		return types.BoolValue(*api)
+	}
+	if current.IsNull() || current.IsUnknown() {
+		return types.BoolValue(false)
+	}
+	return current
+}
+
+// CheckDelete возвращает ошибку, если ресурс защищен от удаления
+func CheckDelete(protected types.Bool, kind, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if protected.ValueBool() {
+		diags.AddError(
+			"Deletion protection enabled",
+			fmt.Sprintf("Cannot delete %s %s: deletion_protection is true. Set deletion_protection = false and apply before destroying it.", kind, id),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,70 @@
+package quantity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Parse разбирает размер в формате Kubernetes quantity (10Gi, 512Mi, 1Ti) в байты
+func Parse(s string) (int64, error) {
+	units := []struct {
+		suffix string
+		mult   int64
+	}{
+		{"Ki", 1 << 10}, {"Mi", 1 << 20}, {"Gi", 1 << 30}, {"Ti", 1 << 40},
+		{"K", 1e3}, {"M", 1e6}, {"G", 1e9}, {"T", 1e12},
+	}
+
+	s = strings.TrimSpace(s)
+	for _, u := range units {
+		if strings.HasSuffix(s, u.suffix) {
+			n, err := strconv.ParseInt(strings.TrimSuffix(s, u.suffix), 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid size %q", s)
+			}
+			return n * u.mult, nil
+		}
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return n, nil
+}
+
+// Equal сообщает, задают ли две записи один и тот же размер (10Gi и 10240Mi)
+func Equal(a, b string) bool {
+	if a == b {
+		return true
+	}
+	x, err := Parse(a)
+	if err != nil {
+		return false
+	}
+	y, err := Parse(b)
+	return err == nil && x == y
+}
+
+// CheckGrowOnly возвращает ошибку, если новый размер меньше текущего
+// (null/unknown значения и нераспознанный текущий размер не проверяются)
+func CheckGrowOnly(name string, current, planned types.String) error {
+	if current.IsNull() || current.IsUnknown() || planned.IsNull() || planned.IsUnknown() {
+		return nil
+	}
+	cur, err := Parse(current.ValueString())
+	if err != nil {
+		return nil
+	}
+	next, err := Parse(planned.ValueString())
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if next < cur {
+		return fmt.Errorf("%s cannot shrink from %s to %s", name, current.ValueString(), planned.ValueString())
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"h3terraform/internal/client"
 	"h3terraform/internal/services/backup"
 	"h3terraform/internal/services/disk"
+	"h3terraform/internal/services/image"
 	"h3terraform/internal/services/net"
 	"h3terraform/internal/services/s3"
 	"h3terraform/internal/services/snapshot"
@@ -30,11 +31,12 @@ type H3Provider struct {
 
 // H3ProviderModel - модель конфигурации провайдера
 type H3ProviderModel struct {
-	APIEndpoint types.String `tfsdk:"api_endpoint"`
-	KeyID       types.String `tfsdk:"key_id"`
-	SecretKey   types.String `tfsdk:"secret_key"`
-	Timeout     types.Int64  `tfsdk:"timeout"`
-	MaxRetries  types.Int64  `tfsdk:"max_retries"`
+	APIEndpoint   types.String `tfsdk:"api_endpoint"`
+	KeyID         types.String `tfsdk:"key_id"`
+	SecretKey     types.String `tfsdk:"secret_key"`
+	Timeout       types.Int64  `tfsdk:"timeout"`
+	MaxRetries    types.Int64  `tfsdk:"max_retries"`
+	DefaultLabels types.Map    `tfsdk:"default_labels"`
 }
 
 // New создает новый экземпляр провайдера
@@ -77,6 +79,11 @@ func (p *H3Provider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				MarkdownDescription: "Maximum retry attempts (default: 3)",
 				Optional:            true,
 			},
+			"default_labels": schema.MapAttribute{
+				MarkdownDescription: "Labels merged into the labels of every resource that supports them (resource labels take precedence)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -137,13 +144,23 @@ func (p *H3Provider) Configure(ctx context.Context, req provider.ConfigureReques
 		maxRetries = config.MaxRetries.ValueInt64()
 	}
 
+	// Default Labels
+	var defaultLabels map[string]string
+	if !config.DefaultLabels.IsNull() {
+		resp.Diagnostics.Append(config.DefaultLabels.ElementsAs(ctx, &defaultLabels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Создаем HTTP клиент с HMAC
 	httpClient, err := client.NewClient(client.Config{
-		BaseURL:    apiEndpoint,
-		KeyID:      keyID,
-		SecretKey:  secretKey,
-		Timeout:    time.Duration(timeout) * time.Second,
-		MaxRetries: int(maxRetries),
+		BaseURL:       apiEndpoint,
+		KeyID:         keyID,
+		SecretKey:     secretKey,
+		Timeout:       time.Duration(timeout) * time.Second,
+		MaxRetries:    int(maxRetries),
+		DefaultLabels: defaultLabels,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -160,7 +177,14 @@ func (p *H3Provider) Configure(ctx context.Context, req provider.ConfigureReques
 func (p *H3Provider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		vm.NewVMResource,
+		vm.NewPlacementGroupResource,
+		vm.NewVMSnapshotResource,
+		vm.NewVMTemplateResource,
+		vm.NewVMGroupResource,
+		vm.NewVMAutoscalerResource,
 		disk.NewDiskResource,
+		disk.NewAttachmentResource,
+		disk.NewRestoreResource,
 		snapshot.NewSnapshotResource,
 		backup.NewBackupResource,
 		net.NewVPCResource,
@@ -168,10 +192,33 @@ func (p *H3Provider) Resources(ctx context.Context) []func() resource.Resource {
 		net.NewEIPResource,
 		s3.NewBucketResource,
 		ssh.NewSSHKeyResource,
+		image.NewImageResource,
 	}
 }
 
 // DataSources возвращает список data sources провайдера
 func (p *H3Provider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		vm.NewVMDataSource,
+		vm.NewVMsDataSource,
+		vm.NewFlavorsDataSource,
+		vm.NewConsoleOutputDataSource,
+		disk.NewDiskDataSource,
+		disk.NewDisksDataSource,
+		snapshot.NewSnapshotDataSource,
+		snapshot.NewSnapshotsDataSource,
+		backup.NewBackupDataSource,
+		backup.NewBackupsDataSource,
+		net.NewVPCDataSource,
+		net.NewVPCsDataSource,
+		net.NewNetworkDataSource,
+		net.NewNetworksDataSource,
+		net.NewEIPDataSource,
+		net.NewEIPsDataSource,
+		s3.NewBucketDataSource,
+		s3.NewBucketsDataSource,
+		ssh.NewSSHKeyDataSource,
+		image.NewImageDataSource,
+		image.NewImagesDataSource,
+	}
 }
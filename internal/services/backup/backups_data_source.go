@@ -0,0 +1,124 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &BackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &BackupsDataSource{}
+)
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
+
+type BackupsDataSource struct {
+	client *client.Client
+}
+
+type BackupsDataSourceModel struct {
+	ProjectID types.String            `tfsdk:"project_id"`
+	NameRegex types.String            `tfsdk:"name_regex"`
+	Status    types.String            `tfsdk:"status"`
+	Labels    types.Map               `tfsdk:"labels"`
+	IDs       types.List              `tfsdk:"ids"`
+	Backups   []BackupDataSourceModel `tfsdk:"backups"`
+}
+
+func (d *BackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backups"
+}
+
+func (d *BackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := filter.Attributes(true)
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID)",
+		Required:            true,
+	}
+	attrs["ids"] = schema.ListAttribute{
+		MarkdownDescription: "IDs of the matching disk backups",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	attrs["backups"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Matching disk backups",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: backupDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists H3 Cloud disk backups in a project, optionally filtered by name regex, status and labels",
+		Attributes:          attrs,
+	}
+}
+
+func (d *BackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BackupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, config.Status, config.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listBackups(ctx, d.client, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing disk backups",
+			fmt.Sprintf("Could not list disk backups: %s", err.Error()),
+		)
+		return
+	}
+
+	ids := []string{}
+	config.Backups = []BackupDataSourceModel{}
+	for _, backup := range items {
+		if !f.Match(backup.Name, backup.Status, backup.Labels) {
+			continue
+		}
+
+		item := backupDataSourceModel(backup)
+		item.ProjectID = config.ProjectID
+
+		ids = append(ids, backup.ID)
+		config.Backups = append(config.Backups, item)
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,212 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &BackupDataSource{}
+var _ datasource.DataSourceWithConfigure = &BackupDataSource{}
+
+func NewBackupDataSource() datasource.DataSource {
+	return &BackupDataSource{}
+}
+
+type BackupDataSource struct {
+	client *client.Client
+}
+
+type BackupDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	SnapshotID types.String `tfsdk:"snapshot_id"`
+	DiskID     types.String `tfsdk:"disk_id"`
+	ProjectID  types.String `tfsdk:"project_id"`
+	Status     types.String `tfsdk:"status"`
+	Size       types.String `tfsdk:"size"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	Labels     types.Map    `tfsdk:"labels"`
+}
+
+func (d *BackupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+func (d *BackupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := backupDataSourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Backup ID (conflicts with name)",
+	}
+	attrs["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Backup name (conflicts with id)",
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Project ID",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing backup by ID or by name within a project",
+		Attributes:          attrs,
+	}
+}
+
+func backupDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Backup ID",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Backup name",
+			Computed:            true,
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "Project ID",
+			Computed:            true,
+		},
+		"snapshot_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Snapshot ID",
+		},
+		"disk_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Disk ID",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Backup status",
+		},
+		"size": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Backup size",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Creation timestamp",
+		},
+		"labels": schema.MapAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "Labels attached to the backup",
+		},
+	}
+}
+
+func (d *BackupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *BackupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BackupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+
+	if hasID == hasName {
+		resp.Diagnostics.AddError("Invalid backup lookup", "Exactly one of id or name must be provided")
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+
+	var backup Backup
+	if hasID {
+		queryParams := map[string]string{
+			"project_id": projectID,
+		}
+
+		err := d.client.Do(ctx, "GET", "/api/disks/v1/backups/"+config.ID.ValueString(), queryParams, nil, &backup)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading backup", err.Error())
+			return
+		}
+	} else {
+		found, err := findBackupByName(ctx, d.client, projectID, config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up backup", err.Error())
+			return
+		}
+		backup = *found
+	}
+
+	state := backupDataSourceModel(backup)
+	state.ProjectID = config.ProjectID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func backupDataSourceModel(backup Backup) BackupDataSourceModel {
+	return BackupDataSourceModel{
+		ID:         types.StringValue(backup.ID),
+		Name:       types.StringValue(backup.Name),
+		SnapshotID: types.StringValue(backup.SnapshotID),
+		DiskID:     types.StringValue(backup.DiskID),
+		ProjectID:  types.StringValue(backup.ProjectID),
+		Status:     types.StringValue(backup.Status),
+		Size:       types.StringValue(backup.Size),
+		CreatedAt:  types.StringValue(backup.CreatedAt),
+		Labels:     labels.Value(backup.Labels),
+	}
+}
+
+func listBackups(ctx context.Context, c *client.Client, projectID string) ([]Backup, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	var backups []Backup
+	for {
+		var listResp BackupListResponse
+		if err := c.Do(ctx, "GET", "/api/disks/v1/backups", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		backups = append(backups, listResp.Backups...)
+		if listResp.NextPageToken == "" {
+			return backups, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+func findBackupByName(ctx context.Context, c *client.Client, projectID, name string) (*Backup, error) {
+	backups, err := listBackups(ctx, c, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Backup
+	for _, backup := range backups {
+		if backup.Name == name {
+			found = append(found, backup)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("backup %q not found in project %s", name, projectID)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d backups named %q in project %s", len(found), name, projectID)
+	}
+}
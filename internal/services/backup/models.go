@@ -1,18 +1,33 @@
 package backup
 
 type Backup struct {
-	ID         string `json:"id"`
-	Name       string `json:"name"`
-	DiskID     string `json:"disk_id"`
-	SnapshotID string `json:"snapshot_id"`
-	Status     string `json:"status"`
-	Size       string `json:"size"`
-	CreatedAt  string `json:"created_at"`
-	ProjectID  string `json:"project_id"`
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	DiskID     string            `json:"disk_id"`
+	SnapshotID string            `json:"snapshot_id"`
+	Status     string            `json:"status"`
+	Size       string            `json:"size"`
+	CreatedAt  string            `json:"created_at"`
+	ProjectID  string            `json:"project_id"`
+	Labels     map[string]string `json:"labels,omitempty"`
+
+	DeletionProtection *bool `json:"deletion_protection,omitempty"`
 }
 
 type CreateBackupRequest struct {
-	SnapshotID string `json:"snapshot_id"`
-	ProjectID  string `json:"project_id"`
-	Name       string `json:"name"`
+	SnapshotID         string            `json:"snapshot_id"`
+	ProjectID          string            `json:"project_id"`
+	Name               string            `json:"name"`
+	Labels             map[string]string `json:"labels,omitempty"`
+	DeletionProtection bool              `json:"deletion_protection,omitempty"`
+}
+
+type UpdateBackupRequest struct {
+	Labels             *map[string]string `json:"labels,omitempty"`
+	DeletionProtection *bool              `json:"deletion_protection,omitempty"`
+}
+
+type BackupListResponse struct {
+	Backups       []Backup `json:"backups"`
+	NextPageToken string   `json:"next_page_token,omitempty"`
 }
@@ -5,7 +5,10 @@ import (
 	"time"
 
 	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+	"h3terraform/internal/pkg/protection"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +20,7 @@ import (
 var _ resource.Resource = &BackupResource{}
 var _ resource.ResourceWithConfigure = &BackupResource{}
 var _ resource.ResourceWithImportState = &BackupResource{}
+var _ resource.ResourceWithModifyPlan = &BackupResource{}
 
 func NewBackupResource() resource.Resource {
 	return &BackupResource{}
@@ -27,14 +31,17 @@ type BackupResource struct {
 }
 
 type BackupResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	SnapshotID types.String `tfsdk:"snapshot_id"`
-	ProjectID  types.String `tfsdk:"project_id"`
-	DiskID     types.String `tfsdk:"disk_id"`
-	Status     types.String `tfsdk:"status"`
-	Size       types.String `tfsdk:"size"`
-	CreatedAt  types.String `tfsdk:"created_at"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	SnapshotID         types.String `tfsdk:"snapshot_id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	DiskID             types.String `tfsdk:"disk_id"`
+	Status             types.String `tfsdk:"status"`
+	Size               types.String `tfsdk:"size"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Labels             types.Map    `tfsdk:"labels"`
+	LabelsAll          types.Map    `tfsdk:"labels_all"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (r *BackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,6 +96,9 @@ func (r *BackupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: "Creation timestamp",
 			},
+			"labels":              labels.Attribute(),
+			"labels_all":          labels.AllAttribute(),
+			"deletion_protection": protection.Attribute(),
 		},
 	}
 }
@@ -100,6 +110,10 @@ func (r *BackupResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = req.ProviderData.(*client.Client)
 }
 
+func (r *BackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+}
+
 func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan BackupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -113,6 +127,14 @@ func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest,
 		Name:       plan.Name.ValueString(),
 	}
 
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Labels = allLabels
+	createReq.DeletionProtection = plan.DeletionProtection.ValueBool()
+
 	var backup Backup
 	err := r.client.Do(ctx, "POST", "/api/disks/v1/backups", nil, createReq, &backup)
 	if err != nil {
@@ -157,16 +179,50 @@ func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.Size = types.StringValue(backup.Size)
 	state.DiskID = types.StringValue(backup.DiskID)
 
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, backup.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+	state.LabelsAll = labels.Value(backup.Labels)
+	state.DeletionProtection = protection.FromAPI(backup.DeletionProtection, state.DeletionProtection)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan BackupResourceModel
+	var plan, state BackupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var updateReq UpdateBackupRequest
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Labels = &allLabels
+	}
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		protected := plan.DeletionProtection.ValueBool()
+		updateReq.DeletionProtection = &protected
+	}
+
+	if updateReq.Labels != nil || updateReq.DeletionProtection != nil {
+		err := r.client.Do(ctx, "PATCH", "/api/disks/v1/backups/"+state.ID.ValueString(), nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating backup", err.Error())
+			return
+		}
+	}
+
+	plan.Status = state.Status
+	plan.Size = state.Size
+	plan.CreatedAt = state.CreatedAt
+	plan.DiskID = state.DiskID
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -177,6 +233,11 @@ func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(protection.CheckDelete(state.DeletionProtection, "backup", state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.Do(ctx, "DELETE", "/api/disks/v1/backups/"+state.ID.ValueString(), nil, nil, nil)
 	if err != nil {
 		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
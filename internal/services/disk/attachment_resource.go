@@ -0,0 +1,277 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"h3terraform/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AttachmentResource{}
+var _ resource.ResourceWithConfigure = &AttachmentResource{}
+var _ resource.ResourceWithImportState = &AttachmentResource{}
+
+// NewAttachmentResource создает новый ресурс подключения диска к VM
+func NewAttachmentResource() resource.Resource {
+	return &AttachmentResource{}
+}
+
+// AttachmentResource - ресурс для подключения диска к VM
+type AttachmentResource struct {
+	client *client.Client
+}
+
+// AttachmentResourceModel - модель состояния ресурса
+type AttachmentResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	DiskID     types.String `tfsdk:"disk_id"`
+	VMID       types.String `tfsdk:"vm_id"`
+	DeviceName types.String `tfsdk:"device_name"`
+	ReadOnly   types.Bool   `tfsdk:"read_only"`
+}
+
+// Metadata возвращает метаданные ресурса
+func (r *AttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk_attachment"
+}
+
+// Schema определяет схему ресурса
+func (r *AttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches an `h3_disk` to an `h3_vm`. The disk is detached when the resource is destroyed",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Attachment ID (same as disk_id)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disk_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Disk ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "VM ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Device name inside the guest (e.g., 'vdb'); assigned by the platform if not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Attach the disk read-only (default: false)",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure инициализирует ресурс с клиентом
+func (r *AttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+// Create подключает диск к VM и ждет завершения
+func (r *AttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachReq := AttachDiskRequest{
+		DiskID:     plan.DiskID.ValueString(),
+		VMID:       plan.VMID.ValueString(),
+		DeviceName: plan.DeviceName.ValueString(),
+		ReadOnly:   plan.ReadOnly.ValueBool(),
+	}
+
+	err := r.client.Do(ctx, "POST", "/api/disks/v1/attach", nil, attachReq, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error attaching disk", err.Error())
+		return
+	}
+
+	disk, err := r.waitForAttachment(ctx, attachReq.DiskID, attachReq.VMID, 5*time.Minute)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for disk attachment",
+			"Disk attach initiated but not completed: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(disk.ID)
+	plan.DeviceName = types.StringValue(disk.DeviceName)
+	plan.ReadOnly = types.BoolValue(disk.ReadOnly)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read проверяет, что диск все еще подключен к этой VM
+func (r *AttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var disk Disk
+	err := r.client.Do(ctx, "GET", "/api/disks/v1/"+state.ID.ValueString(), nil, nil, &disk)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading disk", err.Error())
+		return
+	}
+
+	// Диск отключили (или переподключили к другой VM) вне Terraform
+	if disk.AttachedToVMID == "" || (!state.VMID.IsNull() && disk.AttachedToVMID != state.VMID.ValueString()) {
+		log.Printf("[DEBUG] Disk %s is no longer attached to VM %s (attached_to_vm_id=%q)", disk.ID, state.VMID.ValueString(), disk.AttachedToVMID)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.DiskID = types.StringValue(disk.ID)
+	state.VMID = types.StringValue(disk.AttachedToVMID)
+	state.DeviceName = types.StringValue(disk.DeviceName)
+	state.ReadOnly = types.BoolValue(disk.ReadOnly)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update не поддерживается - все атрибуты требуют пересоздания
+func (r *AttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"Disk attachments cannot be updated in-place. Changes require resource replacement.",
+	)
+}
+
+// Delete отключает диск от VM
+func (r *AttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detachReq := DetachDiskRequest{
+		DiskID: state.DiskID.ValueString(),
+		VMID:   state.VMID.ValueString(),
+	}
+
+	err := r.client.Do(ctx, "POST", "/api/disks/v1/detach", nil, detachReq, nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			// Already detached
+			return
+		}
+		resp.Diagnostics.AddError("Error detaching disk", err.Error())
+		return
+	}
+
+	if err := r.waitForDetachment(ctx, detachReq.DiskID, 5*time.Minute); err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for disk detachment",
+			"Disk detach initiated but not completed: "+err.Error(),
+		)
+	}
+}
+
+// ImportState импортирует подключение по ID диска
+func (r *AttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForAttachment ждет пока диск будет подключен к указанной VM
+func (r *AttachmentResource) waitForAttachment(ctx context.Context, diskID, vmID string, timeout time.Duration) (*Disk, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout waiting for disk %s to attach to VM %s", diskID, vmID)
+		case <-ticker.C:
+			var disk Disk
+			if err := r.client.Do(ctx, "GET", "/api/disks/v1/"+diskID, nil, nil, &disk); err != nil {
+				return nil, err
+			}
+
+			log.Printf("[DEBUG] waitForAttachment: disk %s Status=%s, AttachedToVMID=%s", diskID, disk.Status, disk.AttachedToVMID)
+
+			if disk.Status == "ERROR" {
+				return nil, fmt.Errorf("disk entered ERROR state")
+			}
+			if disk.AttachedToVMID == vmID {
+				return &disk, nil
+			}
+		}
+	}
+}
+
+// waitForDetachment ждет пока диск будет отключен от VM
+func (r *AttachmentResource) waitForDetachment(ctx context.Context, diskID string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for disk %s to detach", diskID)
+		case <-ticker.C:
+			var disk Disk
+			if err := r.client.Do(ctx, "GET", "/api/disks/v1/"+diskID, nil, nil, &disk); err != nil {
+				if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+					return nil
+				}
+				return err
+			}
+
+			log.Printf("[DEBUG] waitForDetachment: disk %s Status=%s, AttachedToVMID=%s", diskID, disk.Status, disk.AttachedToVMID)
+
+			if disk.AttachedToVMID == "" {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DiskDataSource{}
+var _ datasource.DataSourceWithConfigure = &DiskDataSource{}
+
+// NewDiskDataSource создает новый data source Disk
+func NewDiskDataSource() datasource.DataSource {
+	return &DiskDataSource{}
+}
+
+// DiskDataSource - data source для поиска существующего диска
+type DiskDataSource struct {
+	client *client.Client
+}
+
+// DiskDataSourceModel - модель состояния data source
+type DiskDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Size           types.String `tfsdk:"size"`
+	StorageClass   types.String `tfsdk:"storage_class"`
+	Status         types.String `tfsdk:"status"`
+	AttachedToVMID types.String `tfsdk:"attached_to_vm_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Labels         types.Map    `tfsdk:"labels"`
+}
+
+// Metadata возвращает метаданные data source
+func (d *DiskDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk"
+}
+
+// Schema определяет схему data source
+func (d *DiskDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := diskDataSourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Disk ID (conflicts with name)",
+	}
+	attrs["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Disk name (conflicts with id)",
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Project ID, required when looking up by name",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing disk by ID or by name within a project",
+		Attributes:          attrs,
+	}
+}
+
+// diskDataSourceAttributes - read-only атрибуты, общие для singular и plural data source
+func diskDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Disk ID",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Disk name",
+			Computed:            true,
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "Project ID",
+			Computed:            true,
+		},
+		"size": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Disk size",
+		},
+		"storage_class": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Storage class",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Disk status",
+		},
+		"attached_to_vm_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "VM ID if attached",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Creation timestamp",
+		},
+		"labels": schema.MapAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "Labels attached to the disk",
+		},
+	}
+}
+
+// Configure инициализирует data source с клиентом
+func (d *DiskDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read ищет диск по ID или по имени в проекте
+func (d *DiskDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DiskDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+
+	if hasID == hasName {
+		resp.Diagnostics.AddError("Invalid disk lookup", "Exactly one of id or name must be provided")
+		return
+	}
+
+	var disk Disk
+	if hasID {
+		err := d.client.Do(ctx, "GET", "/api/disks/v1/"+config.ID.ValueString(), nil, nil, &disk)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading disk", err.Error())
+			return
+		}
+	} else {
+		if config.ProjectID.IsNull() || config.ProjectID.ValueString() == "" {
+			resp.Diagnostics.AddError("Missing project_id", "project_id is required when looking up a disk by name")
+			return
+		}
+
+		found, err := findDiskByName(ctx, d.client, config.ProjectID.ValueString(), config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up disk", err.Error())
+			return
+		}
+		disk = *found
+	}
+
+	state := diskDataSourceModel(disk)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// diskDataSourceModel конвертирует ответ API в модель data source
+func diskDataSourceModel(disk Disk) DiskDataSourceModel {
+	return DiskDataSourceModel{
+		ID:             types.StringValue(disk.ID),
+		Name:           types.StringValue(disk.Name),
+		ProjectID:      types.StringValue(disk.ProjectID),
+		Size:           types.StringValue(disk.Size),
+		StorageClass:   types.StringValue(disk.StorageClass),
+		Status:         types.StringValue(disk.Status),
+		AttachedToVMID: types.StringValue(disk.AttachedToVMID),
+		CreatedAt:      types.StringValue(disk.CreatedAt),
+		Labels:         labels.Value(disk.Labels),
+	}
+}
+
+// listDisks возвращает все диски проекта
+func listDisks(ctx context.Context, c *client.Client, projectID string) ([]Disk, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	// Backend отдает список постранично, идем по next_page_token до конца
+	var disks []Disk
+	for {
+		var listResp DiskListResponse
+		if err := c.Do(ctx, "GET", "/api/disks/v1", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		disks = append(disks, listResp.Disks...)
+		if listResp.NextPageToken == "" {
+			return disks, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+// findDiskByName ищет диск по имени в проекте (имя должно быть уникальным)
+func findDiskByName(ctx context.Context, c *client.Client, projectID, name string) (*Disk, error) {
+	disks, err := listDisks(ctx, c, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Disk
+	for _, disk := range disks {
+		if disk.Name == name {
+			found = append(found, disk)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("disk %q not found in project %s", name, projectID)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d disks named %q in project %s", len(found), name, projectID)
+	}
+}
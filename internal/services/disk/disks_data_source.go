@@ -0,0 +1,130 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &DisksDataSource{}
+	_ datasource.DataSourceWithConfigure = &DisksDataSource{}
+)
+
+// NewDisksDataSource создает новый data source списка дисков
+func NewDisksDataSource() datasource.DataSource {
+	return &DisksDataSource{}
+}
+
+// DisksDataSource - data source для получения списка дисков проекта с фильтрацией
+type DisksDataSource struct {
+	client *client.Client
+}
+
+// DisksDataSourceModel - модель состояния data source
+type DisksDataSourceModel struct {
+	ProjectID types.String          `tfsdk:"project_id"`
+	NameRegex types.String          `tfsdk:"name_regex"`
+	Status    types.String          `tfsdk:"status"`
+	Labels    types.Map             `tfsdk:"labels"`
+	IDs       types.List            `tfsdk:"ids"`
+	Disks     []DiskDataSourceModel `tfsdk:"disks"`
+}
+
+// Metadata возвращает метаданные data source
+func (d *DisksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disks"
+}
+
+// Schema определяет схему data source
+func (d *DisksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := filter.Attributes(true)
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID)",
+		Required:            true,
+	}
+	attrs["ids"] = schema.ListAttribute{
+		MarkdownDescription: "IDs of the matching disks",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	attrs["disks"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Matching disks",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: diskDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists H3 Cloud disks in a project, optionally filtered by name regex, status and labels",
+		Attributes:          attrs,
+	}
+}
+
+// Configure инициализирует data source с клиентом
+func (d *DisksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read получает все диски проекта и применяет фильтр
+func (d *DisksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DisksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, config.Status, config.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listDisks(ctx, d.client, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing disks",
+			fmt.Sprintf("Could not list disks: %s", err.Error()),
+		)
+		return
+	}
+
+	ids := []string{}
+	config.Disks = []DiskDataSourceModel{}
+	for _, disk := range items {
+		if !f.Match(disk.Name, disk.Status, disk.Labels) {
+			continue
+		}
+
+		item := diskDataSourceModel(disk)
+
+		ids = append(ids, disk.ID)
+		config.Disks = append(config.Disks, item)
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -2,22 +2,36 @@ package disk
 
 // Disk - модель диска для Terraform
 type Disk struct {
-	ID             string `json:"id"`
-	Name           string `json:"name"`
-	ProjectID      string `json:"project_id"`
-	Size           string `json:"size"`
-	StorageClass   string `json:"storage_class"`
-	Status         string `json:"status"`
-	AttachedToVMID string `json:"attached_to_vm_id"`
-	CreatedAt      string `json:"created_at"`
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	ProjectID      string            `json:"project_id"`
+	Size           string            `json:"size"`
+	StorageClass   string            `json:"storage_class"`
+	Status         string            `json:"status"`
+	AttachedToVMID string            `json:"attached_to_vm_id"`
+	DeviceName     string            `json:"device_name,omitempty"`
+	ReadOnly       bool              `json:"read_only,omitempty"`
+	CreatedAt      string            `json:"created_at"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	// DeletionProtection - nil, если backend не сообщает флаг
+	DeletionProtection *bool `json:"deletion_protection,omitempty"`
 }
 
 // CreateDiskRequest - запрос на создание диска
 type CreateDiskRequest struct {
-	ProjectID    string `json:"project_id"`
-	Name         string `json:"name"`
-	Size         string `json:"size"`
-	StorageClass string `json:"storage_class"`
+	ProjectID    string            `json:"project_id"`
+	Name         string            `json:"name"`
+	Size         string            `json:"size"`
+	StorageClass string            `json:"storage_class"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	// DeletionProtection - защита от удаления на стороне backend
+	DeletionProtection bool `json:"deletion_protection,omitempty"`
+}
+
+// UpdateDiskRequest - запрос на обновление labels и deletion_protection диска
+type UpdateDiskRequest struct {
+	Labels             *map[string]string `json:"labels,omitempty"`
+	DeletionProtection *bool              `json:"deletion_protection,omitempty"`
 }
 
 type ResizeDiskRequest struct {
@@ -26,8 +40,10 @@ type ResizeDiskRequest struct {
 }
 
 type AttachDiskRequest struct {
-	DiskID string `json:"disk_id"`
-	VMID   string `json:"vm_id"`
+	DiskID     string `json:"disk_id"`
+	VMID       string `json:"vm_id"`
+	DeviceName string `json:"device_name,omitempty"`
+	ReadOnly   bool   `json:"read_only,omitempty"`
 }
 
 type DetachDiskRequest struct {
@@ -52,9 +68,11 @@ type CreateSnapshotRequest struct {
 }
 
 type RestoreSnapshotRequest struct {
-	SnapshotID  string `json:"snapshot_id"`
-	NewDiskName string `json:"new_disk_name"`
-	ProjectID   string `json:"project_id"`
+	SnapshotID   string            `json:"snapshot_id"`
+	NewDiskName  string            `json:"new_disk_name"`
+	StorageClass string            `json:"storage_class,omitempty"`
+	ProjectID    string            `json:"project_id"`
+	Labels       map[string]string `json:"labels,omitempty"`
 }
 
 type Backup struct {
@@ -75,10 +93,11 @@ type CreateBackupRequest struct {
 }
 
 type RestoreBackupRequest struct {
-	BackupID     string `json:"backup_id"`
-	DiskName     string `json:"disk_name"`
-	StorageClass string `json:"storage_class"`
-	ProjectID    string `json:"project_id"`
+	BackupID     string            `json:"backup_id"`
+	DiskName     string            `json:"disk_name"`
+	StorageClass string            `json:"storage_class"`
+	ProjectID    string            `json:"project_id"`
+	Labels       map[string]string `json:"labels,omitempty"`
 }
 
 type Restore struct {
@@ -94,3 +113,9 @@ type Restore struct {
 	Message     string `json:"message"`
 	CreatedAt   string `json:"created_at"`
 }
+
+// DiskListResponse - ответ API со списком дисков проекта
+type DiskListResponse struct {
+	Disks         []Disk `json:"disks"`
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
@@ -2,10 +2,15 @@ package disk
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+	"h3terraform/internal/pkg/protection"
+	"h3terraform/internal/pkg/quantity"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +22,7 @@ import (
 var _ resource.Resource = &DiskResource{}
 var _ resource.ResourceWithConfigure = &DiskResource{}
 var _ resource.ResourceWithImportState = &DiskResource{}
+var _ resource.ResourceWithModifyPlan = &DiskResource{}
 
 // NewDiskResource создает новый ресурс Disk
 func NewDiskResource() resource.Resource {
@@ -30,14 +36,19 @@ type DiskResource struct {
 
 // DiskResourceModel - модель состояния ресурса
 type DiskResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	Size           types.String `tfsdk:"size"`
-	StorageClass   types.String `tfsdk:"storage_class"`
-	Status         types.String `tfsdk:"status"`
-	AttachedToVMID types.String `tfsdk:"attached_to_vm_id"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	Size               types.String `tfsdk:"size"`
+	StorageClass       types.String `tfsdk:"storage_class"`
+	SourceSnapshotID   types.String `tfsdk:"source_snapshot_id"`
+	SourceBackupID     types.String `tfsdk:"source_backup_id"`
+	Status             types.String `tfsdk:"status"`
+	AttachedToVMID     types.String `tfsdk:"attached_to_vm_id"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Labels             types.Map    `tfsdk:"labels"`
+	LabelsAll          types.Map    `tfsdk:"labels_all"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 // Metadata возвращает метаданные ресурса
@@ -72,8 +83,12 @@ func (r *DiskResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"size": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Disk size (e.g., '10Gi')",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Disk size (e.g., '10Gi'). Required unless restoring from a snapshot or backup, in which case it defaults to the source size, a larger value grows the restored disk and a smaller one is rejected",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"storage_class": schema.StringAttribute{
 				Required:            true,
@@ -82,18 +97,35 @@ func (r *DiskResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"source_snapshot_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Restore the disk from this snapshot (mutually exclusive with source_backup_id)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_backup_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Restore the disk from this backup (mutually exclusive with source_snapshot_id)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Disk status",
 			},
 			"attached_to_vm_id": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "VM ID if attached",
+				MarkdownDescription: "VM ID if attached (see `h3_disk_attachment`)",
 			},
 			"created_at": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Creation timestamp",
 			},
+			"labels":              labels.Attribute(),
+			"labels_all":          labels.AllAttribute(),
+			"deletion_protection": protection.Attribute(),
 		},
 	}
 }
@@ -106,6 +138,43 @@ func (r *DiskResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = req.ProviderData.(*client.Client)
 }
 
+// ModifyPlan добавляет default_labels провайдера в labels_all и проверяет,
+// что диск, восстанавливаемый из snapshot или backup, не меньше источника
+func (r *DiskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan DiskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Size.IsNull() || plan.Size.IsUnknown() || plan.ProjectID.IsUnknown() ||
+		plan.SourceSnapshotID.IsUnknown() || plan.SourceBackupID.IsUnknown() {
+		return
+	}
+	if plan.SourceSnapshotID.ValueString() == "" && plan.SourceBackupID.ValueString() == "" {
+		return
+	}
+
+	srcSize, err := sourceSize(ctx, r.client, plan.ProjectID.ValueString(), plan.SourceSnapshotID.ValueString(), plan.SourceBackupID.ValueString())
+	if err != nil {
+		// Источник может еще не существовать (создается в том же apply) - проверим в Create
+		log.Printf("[DEBUG] ModifyPlan: could not read restore source size: %s", err)
+		return
+	}
+	if err := quantity.CheckGrowOnly("size", types.StringValue(srcSize), plan.Size); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("size"),
+			"Invalid disk size",
+			err.Error()+". A restored disk can only be as large as its source or larger",
+		)
+	}
+}
+
 // Create создает новый диск
 func (r *DiskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DiskResourceModel
@@ -114,11 +183,44 @@ func (r *DiskResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	hasSnapshot := !plan.SourceSnapshotID.IsNull() && plan.SourceSnapshotID.ValueString() != ""
+	hasBackup := !plan.SourceBackupID.IsNull() && plan.SourceBackupID.ValueString() != ""
+
+	if hasSnapshot && hasBackup {
+		resp.Diagnostics.AddError(
+			"Conflicting restore source",
+			"source_snapshot_id and source_backup_id are mutually exclusive - provide only one",
+		)
+		return
+	}
+
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hasSnapshot || hasBackup {
+		r.createFromSource(ctx, plan, allLabels, resp)
+		return
+	}
+
+	if plan.Size.IsNull() || plan.Size.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Missing disk size",
+			"size is required unless source_snapshot_id or source_backup_id is set",
+		)
+		return
+	}
+
 	createReq := CreateDiskRequest{
 		ProjectID:    plan.ProjectID.ValueString(),
 		Name:         plan.Name.ValueString(),
 		Size:         plan.Size.ValueString(),
 		StorageClass: plan.StorageClass.ValueString(),
+		Labels:       allLabels,
+
+		DeletionProtection: plan.DeletionProtection.ValueBool(),
 	}
 
 	var disk Disk
@@ -139,6 +241,83 @@ func (r *DiskResource) Create(ctx context.Context, req resource.CreateRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// createFromSource создает диск восстановлением из snapshot или backup:
+// ждет завершения задачи Restore, готовности диска и при необходимости увеличивает размер
+func (r *DiskResource) createFromSource(ctx context.Context, plan DiskResourceModel, allLabels map[string]string, resp *resource.CreateResponse) {
+	job, err := restoreDisk(ctx, r.client,
+		plan.ProjectID.ValueString(),
+		plan.Name.ValueString(),
+		plan.StorageClass.ValueString(),
+		plan.SourceSnapshotID.ValueString(),
+		plan.SourceBackupID.ValueString(),
+		allLabels,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error restoring disk", err.Error())
+		return
+	}
+
+	job, err = waitForRestore(ctx, r.client, job.ID, 60*time.Minute)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for disk restore", err.Error())
+		return
+	}
+	log.Printf("[DEBUG] Restore %s completed: DiskID=%s, StartedAt=%s, CompletedAt=%s", job.ID, job.DiskID, job.StartedAt, job.CompletedAt)
+
+	// Сохраняем ID сразу, чтобы диск не потерялся, если дальше что-то упадет
+	plan.ID = types.StringValue(job.DiskID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+
+	disk, err := waitForDiskAvailable(ctx, r.client, job.DiskID, 10*time.Minute)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for restored disk",
+			"Disk restored but not available: "+err.Error(),
+		)
+		return
+	}
+
+	// Размер больше исходного - увеличиваем восстановленный диск (уменьшать нельзя)
+	grow := false
+	if !plan.Size.IsNull() && !plan.Size.IsUnknown() {
+		if err := quantity.CheckGrowOnly("size", types.StringValue(disk.Size), plan.Size); err != nil {
+			resp.Diagnostics.AddError("Invalid disk size", err.Error()+". A restored disk can only be as large as its source or larger")
+			return
+		}
+		planned, _ := quantity.Parse(plan.Size.ValueString())
+		current, err := quantity.Parse(disk.Size)
+		grow = err == nil && planned > current
+	}
+	if grow {
+		resizeReq := ResizeDiskRequest{
+			DiskID:  disk.ID,
+			NewSize: plan.Size.ValueString(),
+		}
+		if err := r.client.Do(ctx, "POST", "/api/disks/v1/resize", nil, resizeReq, nil); err != nil {
+			resp.Diagnostics.AddError("Error resizing restored disk", err.Error())
+			return
+		}
+	} else if plan.Size.IsNull() || plan.Size.IsUnknown() {
+		plan.Size = types.StringValue(disk.Size)
+	}
+
+	// Restore API не принимает deletion_protection, включаем отдельным запросом
+	if plan.DeletionProtection.ValueBool() {
+		protected := true
+		updateReq := UpdateDiskRequest{DeletionProtection: &protected}
+		if err := r.client.Do(ctx, "PATCH", "/api/disks/v1/"+disk.ID, nil, updateReq, nil); err != nil {
+			resp.Diagnostics.AddError("Error enabling deletion protection", err.Error())
+			return
+		}
+	}
+
+	plan.Status = types.StringValue(disk.Status)
+	plan.AttachedToVMID = types.StringValue(disk.AttachedToVMID)
+	plan.CreatedAt = types.StringValue(disk.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
 // Read читает текущее состояние диска
 func (r *DiskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state DiskResourceModel
@@ -158,13 +337,28 @@ func (r *DiskResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	state.Name = types.StringValue(disk.Name)
+	state.ProjectID = types.StringValue(disk.ProjectID)
+	state.StorageClass = types.StringValue(disk.StorageClass)
+	state.CreatedAt = types.StringValue(disk.CreatedAt)
 	state.Status = types.StringValue(disk.Status)
 	state.AttachedToVMID = types.StringValue(disk.AttachedToVMID)
+	// Размер из API может быть записан в другой форме (10Gi и 10240Mi),
+	// поэтому значение из state сохраняется, если оно численно совпадает
+	if !sameSize(state.Size, disk.Size) {
+		state.Size = types.StringValue(disk.Size)
+	}
+
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, disk.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+	state.LabelsAll = labels.Value(disk.Labels)
+	state.DeletionProtection = protection.FromAPI(disk.DeletionProtection, state.DeletionProtection)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Update обновляет диск (только размер)
+// Update обновляет диск (размер и labels)
 func (r *DiskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state DiskResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -173,7 +367,7 @@ func (r *DiskResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Only size can be updated
+	// Resize
 	if !plan.Size.Equal(state.Size) {
 		resizeReq := ResizeDiskRequest{
 			DiskID:  state.ID.ValueString(),
@@ -187,6 +381,33 @@ func (r *DiskResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
+	// Labels и deletion_protection
+	var updateReq UpdateDiskRequest
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Labels = &allLabels
+	}
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		protected := plan.DeletionProtection.ValueBool()
+		updateReq.DeletionProtection = &protected
+	}
+
+	if updateReq.Labels != nil || updateReq.DeletionProtection != nil {
+		err := r.client.Do(ctx, "PATCH", "/api/disks/v1/"+state.ID.ValueString(), nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating disk", err.Error())
+			return
+		}
+	}
+
+	plan.Status = state.Status
+	plan.AttachedToVMID = state.AttachedToVMID
+	plan.CreatedAt = state.CreatedAt
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -198,6 +419,11 @@ func (r *DiskResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(protection.CheckDelete(state.DeletionProtection, "disk", state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.Do(ctx, "DELETE", "/api/disks/v1/"+state.ID.ValueString(), nil, nil, nil)
 	if err != nil {
 		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
@@ -212,3 +438,8 @@ func (r *DiskResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *DiskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// sameSize сообщает, задаёт ли значение из state тот же размер, что и API
+func sameSize(current types.String, apiSize string) bool {
+	return !current.IsNull() && !current.IsUnknown() && quantity.Equal(current.ValueString(), apiSize)
+}
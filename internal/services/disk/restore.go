@@ -0,0 +1,123 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"h3terraform/internal/client"
+)
+
+// restoreDisk запускает восстановление диска из snapshot или backup и возвращает задачу Restore
+func restoreDisk(ctx context.Context, c *client.Client, projectID, diskName, storageClass, snapshotID, backupID string, diskLabels map[string]string) (*Restore, error) {
+	var job Restore
+	if snapshotID != "" {
+		restoreReq := RestoreSnapshotRequest{
+			SnapshotID:   snapshotID,
+			NewDiskName:  diskName,
+			StorageClass: storageClass,
+			ProjectID:    projectID,
+			Labels:       diskLabels,
+		}
+		if err := c.Do(ctx, "POST", "/api/disks/v1/snapshots/restore", nil, restoreReq, &job); err != nil {
+			return nil, fmt.Errorf("could not restore snapshot %s: %w", snapshotID, err)
+		}
+	} else {
+		restoreReq := RestoreBackupRequest{
+			BackupID:     backupID,
+			DiskName:     diskName,
+			StorageClass: storageClass,
+			ProjectID:    projectID,
+			Labels:       diskLabels,
+		}
+		if err := c.Do(ctx, "POST", "/api/disks/v1/backups/restore", nil, restoreReq, &job); err != nil {
+			return nil, fmt.Errorf("could not restore backup %s: %w", backupID, err)
+		}
+	}
+	return &job, nil
+}
+
+// sourceSize возвращает размер snapshot или backup, из которого восстанавливается диск
+func sourceSize(ctx context.Context, c *client.Client, projectID, snapshotID, backupID string) (string, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	if snapshotID != "" {
+		var snapshot Snapshot
+		if err := c.Do(ctx, "GET", "/api/disks/v1/snapshots/"+snapshotID, queryParams, nil, &snapshot); err != nil {
+			return "", fmt.Errorf("could not read snapshot %s: %w", snapshotID, err)
+		}
+		return snapshot.Size, nil
+	}
+
+	var backup Backup
+	if err := c.Do(ctx, "GET", "/api/disks/v1/backups/"+backupID, queryParams, nil, &backup); err != nil {
+		return "", fmt.Errorf("could not read backup %s: %w", backupID, err)
+	}
+	return backup.Size, nil
+}
+
+// waitForRestore ждет завершения задачи Restore и возвращает ее финальное состояние
+func waitForRestore(ctx context.Context, c *client.Client, restoreID string, timeout time.Duration) (*Restore, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout waiting for restore %s to complete", restoreID)
+		case <-ticker.C:
+			var job Restore
+			if err := c.Do(ctx, "GET", "/api/disks/v1/restores/"+restoreID, nil, nil, &job); err != nil {
+				return nil, err
+			}
+
+			log.Printf("[DEBUG] waitForRestore: restore %s Status=%s, StartedAt=%s, CompletedAt=%s, Message=%q", restoreID, job.Status, job.StartedAt, job.CompletedAt, job.Message)
+
+			switch job.Status {
+			case "FAILED", "ERROR":
+				return &job, fmt.Errorf("restore %s failed: %s", restoreID, job.Message)
+			case "COMPLETED":
+				if job.DiskID == "" {
+					return &job, fmt.Errorf("restore %s completed without a disk ID", restoreID)
+				}
+				return &job, nil
+			}
+		}
+	}
+}
+
+// waitForDiskAvailable ждет пока диск станет AVAILABLE (или будет подключен к VM)
+func waitForDiskAvailable(ctx context.Context, c *client.Client, diskID string, timeout time.Duration) (*Disk, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout waiting for disk %s to become available", diskID)
+		case <-ticker.C:
+			var disk Disk
+			if err := c.Do(ctx, "GET", "/api/disks/v1/"+diskID, nil, nil, &disk); err != nil {
+				return nil, err
+			}
+
+			log.Printf("[DEBUG] waitForDiskAvailable: disk %s Status=%s", diskID, disk.Status)
+
+			switch disk.Status {
+			case "ERROR":
+				return nil, fmt.Errorf("disk entered ERROR state")
+			case "AVAILABLE", "ATTACHED":
+				return &disk, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"h3terraform/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &RestoreResource{}
+var _ resource.ResourceWithConfigure = &RestoreResource{}
+var _ resource.ResourceWithImportState = &RestoreResource{}
+
+// defaultRestoreCreateTimeout - таймаут восстановления по умолчанию (многотерабайтные backup идут часами)
+const defaultRestoreCreateTimeout = 6 * time.Hour
+
+// NewRestoreResource создает новый ресурс восстановления диска
+func NewRestoreResource() resource.Resource {
+	return &RestoreResource{}
+}
+
+// RestoreResource - ресурс для долгого восстановления диска из backup
+type RestoreResource struct {
+	client *client.Client
+}
+
+// RestoreResourceModel - модель состояния ресурса
+type RestoreResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	ProjectID             types.String   `tfsdk:"project_id"`
+	BackupID              types.String   `tfsdk:"backup_id"`
+	DiskName              types.String   `tfsdk:"disk_name"`
+	StorageClass          types.String   `tfsdk:"storage_class"`
+	PreserveDiskOnDestroy types.Bool     `tfsdk:"preserve_disk_on_destroy"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+	DiskID                types.String   `tfsdk:"disk_id"`
+	Size                  types.String   `tfsdk:"size"`
+	Status                types.String   `tfsdk:"status"`
+	Message               types.String   `tfsdk:"message"`
+	StartedAt             types.String   `tfsdk:"started_at"`
+	CompletedAt           types.String   `tfsdk:"completed_at"`
+	CreatedAt             types.String   `tfsdk:"created_at"`
+}
+
+// Metadata возвращает метаданные ресурса
+func (r *RestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk_restore"
+}
+
+// Schema определяет схему ресурса
+func (r *RestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores a disk from a backup as a long-running job. The restored disk is exposed as `disk_id`",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Restore job ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Project ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Backup to restore from",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"disk_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the disk to create",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage_class": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Storage class of the new disk (e.g., 'replicated')",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preserve_disk_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Keep the restored disk when this resource is destroyed (default: false)",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for the restore to complete, as a Go duration (default: 6h)",
+			}),
+			"disk_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the restored disk",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Size of the restored disk",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Restore job status",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Progress message or failure reason",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Job start timestamp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Job completion timestamp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Job creation timestamp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure инициализирует ресурс с клиентом
+func (r *RestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create запускает восстановление и ждет завершения задачи
+func (r *RestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultRestoreCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := restoreDisk(ctx, r.client,
+		plan.ProjectID.ValueString(),
+		plan.DiskName.ValueString(),
+		plan.StorageClass.ValueString(),
+		"",
+		plan.BackupID.ValueString(),
+		nil,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error starting disk restore", err.Error())
+		return
+	}
+
+	// Сохраняем ID задачи сразу - при ошибке ресурс останется в state (tainted) и не потеряется
+	plan.ID = types.StringValue(job.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+
+	finished, err := waitForRestore(ctx, r.client, job.ID, timeout)
+	if finished != nil {
+		restoreToModel(*finished, &plan)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Disk restore did not complete", err.Error())
+		return
+	}
+
+	if _, err := waitForDiskAvailable(ctx, r.client, finished.DiskID, 10*time.Minute); err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for restored disk",
+			"Disk restored but not available: "+err.Error(),
+		)
+	}
+}
+
+// Read читает состояние задачи восстановления
+func (r *RestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RestoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var job Restore
+	err := r.client.Do(ctx, "GET", "/api/disks/v1/restores/"+state.ID.ValueString(), nil, nil, &job)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading disk restore", err.Error())
+		return
+	}
+
+	restoreToModel(job, &state)
+	if state.PreserveDiskOnDestroy.IsNull() {
+		state.PreserveDiskOnDestroy = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update меняет только локальные настройки (preserve_disk_on_destroy, timeouts)
+func (r *RestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.PreserveDiskOnDestroy = plan.PreserveDiskOnDestroy
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete удаляет восстановленный диск (если не включен preserve_disk_on_destroy)
+func (r *RestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RestoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.PreserveDiskOnDestroy.ValueBool() {
+		return
+	}
+
+	diskID := state.DiskID.ValueString()
+	if diskID == "" {
+		// Create истек по таймауту до появления disk_id: задача еще идет и создаст диск позже,
+		// поэтому дожидаемся ее, чтобы не оставить диск без владельца
+		timeout, diags := state.Timeouts.Create(ctx, defaultRestoreCreateTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		job, err := waitForRestore(ctx, r.client, state.ID.ValueString(), timeout)
+		if err != nil {
+			if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+				return
+			}
+			if job == nil {
+				resp.Diagnostics.AddError(
+					"Error deleting disk restore",
+					fmt.Sprintf("Restore %s is still running and its disk cannot be deleted yet: %s", state.ID.ValueString(), err.Error()),
+				)
+				return
+			}
+		}
+		// Неудачная задача без диска - удалять нечего
+		if job.DiskID == "" {
+			return
+		}
+		diskID = job.DiskID
+	}
+
+	err := r.client.Do(ctx, "DELETE", "/api/disks/v1/"+diskID, nil, nil, nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			// Already deleted
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting restored disk", err.Error())
+	}
+}
+
+// ImportState импортирует задачу восстановления по ID
+func (r *RestoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// restoreToModel копирует поля задачи Restore в модель состояния
+func restoreToModel(job Restore, model *RestoreResourceModel) {
+	model.ID = types.StringValue(job.ID)
+	if job.ProjectID != "" {
+		model.ProjectID = types.StringValue(job.ProjectID)
+	}
+	// backup_ref задачи не обязательно совпадает с ID backup, поэтому берем его только при импорте
+	if model.BackupID.IsNull() && job.BackupRef != "" {
+		model.BackupID = types.StringValue(job.BackupRef)
+	}
+	if job.DiskName != "" {
+		model.DiskName = types.StringValue(job.DiskName)
+	}
+	model.DiskID = types.StringValue(job.DiskID)
+	model.Size = types.StringValue(job.Size)
+	model.Status = types.StringValue(job.Status)
+	model.Message = types.StringValue(job.Message)
+	model.StartedAt = types.StringValue(job.StartedAt)
+	model.CompletedAt = types.StringValue(job.CompletedAt)
+	model.CreatedAt = types.StringValue(job.CreatedAt)
+}
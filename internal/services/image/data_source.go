@@ -0,0 +1,332 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ImageDataSource{}
+	_ datasource.DataSourceWithConfigure = &ImageDataSource{}
+)
+
+// NewImageDataSource создает новый data source образа
+func NewImageDataSource() datasource.DataSource {
+	return &ImageDataSource{}
+}
+
+// ImageDataSource - data source для выбора одного образа из каталога
+type ImageDataSource struct {
+	client *client.Client
+}
+
+// ImageDataSourceModel - модель состояния data source
+type ImageDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	Name         types.String `tfsdk:"name"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	OSFamily     types.String `tfsdk:"os_family"`
+	OSVersion    types.String `tfsdk:"os_version"`
+	Architecture types.String `tfsdk:"architecture"`
+	MostRecent   types.Bool   `tfsdk:"most_recent"`
+	Description  types.String `tfsdk:"description"`
+	Status       types.String `tfsdk:"status"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+// ImageModel - модель образа в списке h3_images
+type ImageModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	OSFamily     types.String `tfsdk:"os_family"`
+	OSVersion    types.String `tfsdk:"os_version"`
+	Architecture types.String `tfsdk:"architecture"`
+	Description  types.String `tfsdk:"description"`
+	Status       types.String `tfsdk:"status"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+// Metadata возвращает метаданные data source
+func (d *ImageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+// Schema определяет схему data source
+func (d *ImageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := imageDataSourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "Image ID (conflicts with the other filters)",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID whose private images (`h3_image`) are searched as well; without it only public catalog images are found",
+		Optional:            true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "Exact image name, e.g. `ubuntu:24.04`",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["os_family"] = schema.StringAttribute{
+		MarkdownDescription: "OS family to match, e.g. `ubuntu`",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["os_version"] = schema.StringAttribute{
+		MarkdownDescription: "OS version to match, e.g. `24.04`",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["architecture"] = schema.StringAttribute{
+		MarkdownDescription: "CPU architecture to match, e.g. `amd64`",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["name_regex"] = schema.StringAttribute{
+		MarkdownDescription: "Regular expression the image name must match",
+		Optional:            true,
+	}
+	attrs["most_recent"] = schema.BoolAttribute{
+		MarkdownDescription: "If several images match, pick the one with the latest `created_at` instead of failing",
+		Optional:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Selects a single OS image from the H3 Cloud catalog by ID or by filters. Filters only consider images that are ready for use",
+		Attributes:          attrs,
+	}
+}
+
+// imageDataSourceAttributes - read-only атрибуты образа, общие для singular и plural data source
+func imageDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Image ID",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Image name, usable as `h3_vm.image`",
+			Computed:            true,
+		},
+		"os_family": schema.StringAttribute{
+			MarkdownDescription: "OS family",
+			Computed:            true,
+		},
+		"os_version": schema.StringAttribute{
+			MarkdownDescription: "OS version",
+			Computed:            true,
+		},
+		"architecture": schema.StringAttribute{
+			MarkdownDescription: "CPU architecture",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Image description",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Image status",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Creation timestamp",
+			Computed:            true,
+		},
+	}
+}
+
+// Configure инициализирует data source с клиентом
+func (d *ImageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read выбирает образ по ID или по фильтрам (с most_recent - самый новый из подходящих)
+func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ImageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasFilters := !config.Name.IsNull() || !config.NameRegex.IsNull() || !config.OSFamily.IsNull() ||
+		!config.OSVersion.IsNull() || !config.Architecture.IsNull()
+
+	if hasID == hasFilters {
+		resp.Diagnostics.AddError(
+			"Invalid image lookup",
+			"Either id or at least one of name, name_regex, os_family, os_version, architecture must be provided",
+		)
+		return
+	}
+
+	var image Image
+	if hasID {
+		err := d.client.Do(ctx, "GET", "/api/images/v1/"+config.ID.ValueString(), projectQuery(config.ProjectID.ValueString()), nil, &image)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading image", err.Error())
+			return
+		}
+	} else {
+		f, diags := filter.New(ctx, config.NameRegex, types.StringNull(), types.MapNull(types.StringType))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		images, err := listImages(ctx, d.client, config.ProjectID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing images", err.Error())
+			return
+		}
+
+		var found []Image
+		for _, img := range images {
+			// Образы в процессе создания или с ошибкой не годятся для h3_vm.image
+			if !imageUsable(img) || !f.Match(img.Name, img.Status, nil) {
+				continue
+			}
+			if !config.Name.IsNull() && img.Name != config.Name.ValueString() {
+				continue
+			}
+			if !matchImage(img, config.OSFamily, config.OSVersion, config.Architecture) {
+				continue
+			}
+			found = append(found, img)
+		}
+
+		switch {
+		case len(found) == 0:
+			resp.Diagnostics.AddError("Image not found", "No ready image in the catalog matches the given filters")
+			return
+		case len(found) > 1 && !config.MostRecent.ValueBool():
+			resp.Diagnostics.AddError(
+				"Multiple images found",
+				fmt.Sprintf("%d images match the given filters; narrow them down or set most_recent = true", len(found)),
+			)
+			return
+		}
+
+		sortByCreatedAtDesc(found)
+		image = found[0]
+	}
+
+	state := ImageDataSourceModel{
+		ID:           types.StringValue(image.ID),
+		ProjectID:    config.ProjectID,
+		Name:         types.StringValue(image.Name),
+		NameRegex:    config.NameRegex,
+		OSFamily:     types.StringValue(image.OSFamily),
+		OSVersion:    types.StringValue(image.OSVersion),
+		Architecture: types.StringValue(image.Architecture),
+		MostRecent:   config.MostRecent,
+		Description:  types.StringValue(image.Description),
+		Status:       types.StringValue(image.Status),
+		CreatedAt:    types.StringValue(image.CreatedAt),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// imageModel конвертирует ответ API в модель образа
+func imageModel(image Image) ImageModel {
+	return ImageModel{
+		ID:           types.StringValue(image.ID),
+		Name:         types.StringValue(image.Name),
+		OSFamily:     types.StringValue(image.OSFamily),
+		OSVersion:    types.StringValue(image.OSVersion),
+		Architecture: types.StringValue(image.Architecture),
+		Description:  types.StringValue(image.Description),
+		Status:       types.StringValue(image.Status),
+		CreatedAt:    types.StringValue(image.CreatedAt),
+	}
+}
+
+// imageUsable проверяет, что образ готов к созданию VM (не FAILED и не в процессе создания)
+func imageUsable(image Image) bool {
+	switch strings.ToUpper(image.Status) {
+	case "READY", "AVAILABLE", "ACTIVE":
+		return true
+	}
+	return false
+}
+
+// matchImage проверяет os_family/os_version/architecture (без учета регистра, null - любое значение)
+func matchImage(image Image, osFamily, osVersion, architecture types.String) bool {
+	if !osFamily.IsNull() && !strings.EqualFold(image.OSFamily, osFamily.ValueString()) {
+		return false
+	}
+	if !osVersion.IsNull() && !strings.EqualFold(image.OSVersion, osVersion.ValueString()) {
+		return false
+	}
+	if !architecture.IsNull() && !strings.EqualFold(image.Architecture, architecture.ValueString()) {
+		return false
+	}
+	return true
+}
+
+// sortByCreatedAtDesc сортирует образы от самого нового к самому старому
+func sortByCreatedAtDesc(images []Image) {
+	sort.SliceStable(images, func(i, j int) bool {
+		ti, erri := time.Parse(time.RFC3339, images[i].CreatedAt)
+		tj, errj := time.Parse(time.RFC3339, images[j].CreatedAt)
+		if erri != nil || errj != nil {
+			return images[i].CreatedAt > images[j].CreatedAt
+		}
+		return ti.After(tj)
+	})
+}
+
+// listImages возвращает весь каталог образов (с projectID - вместе с приватными образами проекта)
+func listImages(ctx context.Context, c *client.Client, projectID string) ([]Image, error) {
+	queryParams := projectQuery(projectID)
+
+	// Backend отдает список постранично, идем по next_page_token до конца
+	var images []Image
+	for {
+		var listResp ImageListResponse
+		if err := c.Do(ctx, "GET", "/api/images/v1", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		images = append(images, listResp.Images...)
+		if listResp.NextPageToken == "" {
+			return images, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+// projectQuery формирует параметры запроса с project_id (пустой projectID не передается)
+func projectQuery(projectID string) map[string]string {
+	queryParams := map[string]string{}
+	if projectID != "" {
+		queryParams["project_id"] = projectID
+	}
+	return queryParams
+}
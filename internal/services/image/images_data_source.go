@@ -0,0 +1,166 @@
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ImagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ImagesDataSource{}
+)
+
+// NewImagesDataSource создает новый data source каталога образов
+func NewImagesDataSource() datasource.DataSource {
+	return &ImagesDataSource{}
+}
+
+// ImagesDataSource - data source для получения каталога образов с фильтрацией
+type ImagesDataSource struct {
+	client *client.Client
+}
+
+// ImagesDataSourceModel - модель состояния data source
+type ImagesDataSourceModel struct {
+	ProjectID    types.String `tfsdk:"project_id"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	OSFamily     types.String `tfsdk:"os_family"`
+	OSVersion    types.String `tfsdk:"os_version"`
+	Architecture types.String `tfsdk:"architecture"`
+	Status       types.String `tfsdk:"status"`
+	MostRecent   types.Bool   `tfsdk:"most_recent"`
+	IDs          types.List   `tfsdk:"ids"`
+	Images       []ImageModel `tfsdk:"images"`
+}
+
+// Metadata возвращает метаданные data source
+func (d *ImagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_images"
+}
+
+// Schema определяет схему data source
+func (d *ImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists OS images from the H3 Cloud catalog, newest first, optionally filtered by name regex, OS family, version, architecture and status",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID whose private images (`h3_image`) are listed as well; without it only public catalog images are returned",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression the image name must match",
+				Optional:            true,
+			},
+			"os_family": schema.StringAttribute{
+				MarkdownDescription: "OS family to match, e.g. `ubuntu`",
+				Optional:            true,
+			},
+			"os_version": schema.StringAttribute{
+				MarkdownDescription: "OS version to match, e.g. `24.04`",
+				Optional:            true,
+			},
+			"architecture": schema.StringAttribute{
+				MarkdownDescription: "CPU architecture to match, e.g. `amd64`",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status to match (case-insensitive). By default only images that are ready for use are returned",
+				Optional:            true,
+			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "Only return the newest matching image",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matching images, newest first",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"images": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching images, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: imageDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure инициализирует data source с клиентом
+func (d *ImagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read получает каталог образов и применяет фильтр
+func (d *ImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ImagesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, config.Status, types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	images, err := listImages(ctx, d.client, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing images",
+			fmt.Sprintf("Could not list images: %s", err.Error()),
+		)
+		return
+	}
+
+	var found []Image
+	for _, img := range images {
+		// Без явного status возвращаем только готовые к использованию образы
+		if config.Status.IsNull() && !imageUsable(img) {
+			continue
+		}
+		if f.Match(img.Name, img.Status, nil) && matchImage(img, config.OSFamily, config.OSVersion, config.Architecture) {
+			found = append(found, img)
+		}
+	}
+
+	sortByCreatedAtDesc(found)
+	if config.MostRecent.ValueBool() && len(found) > 1 {
+		found = found[:1]
+	}
+
+	ids := []string{}
+	config.Images = []ImageModel{}
+	for _, img := range found {
+		ids = append(ids, img.ID)
+		config.Images = append(config.Images, imageModel(img))
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
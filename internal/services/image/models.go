@@ -0,0 +1,54 @@
+package image
+
+// Image - образ ОС из каталога
+type Image struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	OSFamily     string `json:"os_family"`
+	OSVersion    string `json:"os_version"`
+	Architecture string `json:"architecture"`
+	Description  string `json:"description,omitempty"`
+	Status       string `json:"status"`
+	CreatedAt    string `json:"created_at"`
+	// Поля пользовательского образа (h3_image), у образов каталога пустые
+	ProjectID            string            `json:"project_id,omitempty"`
+	SourceDiskID         string            `json:"source_disk_id,omitempty"`
+	SourceSnapshotID     string            `json:"source_snapshot_id,omitempty"`
+	SourceBucketID       string            `json:"source_bucket_id,omitempty"`
+	SourceObjectKey      string            `json:"source_object_key,omitempty"`
+	SourceFormat         string            `json:"source_format,omitempty"`
+	Size                 string            `json:"size,omitempty"`
+	Message              string            `json:"message,omitempty"`
+	SharedWithProjectIDs []string          `json:"shared_with_project_ids,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty"`
+}
+
+// ImageListResponse - ответ API со списком образов каталога
+type ImageListResponse struct {
+	Images        []Image `json:"images"`
+	NextPageToken string  `json:"next_page_token,omitempty"`
+}
+
+// CreateImageRequest - DTO для создания пользовательского образа из диска, снапшота или файла в бакете
+type CreateImageRequest struct {
+	ProjectID            string            `json:"project_id"`
+	Name                 string            `json:"name"`
+	Description          string            `json:"description,omitempty"`
+	SourceDiskID         string            `json:"source_disk_id,omitempty"`
+	SourceSnapshotID     string            `json:"source_snapshot_id,omitempty"`
+	SourceBucketID       string            `json:"source_bucket_id,omitempty"`
+	SourceObjectKey      string            `json:"source_object_key,omitempty"`
+	SourceFormat         string            `json:"source_format,omitempty"`
+	OSFamily             string            `json:"os_family,omitempty"`
+	OSVersion            string            `json:"os_version,omitempty"`
+	SharedWithProjectIDs []string          `json:"shared_with_project_ids,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty"`
+}
+
+// UpdateImageRequest - DTO для обновления пользовательского образа (nil - поле не меняется)
+type UpdateImageRequest struct {
+	Name                 *string            `json:"name,omitempty"`
+	Description          *string            `json:"description,omitempty"`
+	SharedWithProjectIDs *[]string          `json:"shared_with_project_ids,omitempty"`
+	Labels               *map[string]string `json:"labels,omitempty"`
+}
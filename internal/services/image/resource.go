@@ -0,0 +1,547 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &ImageResource{}
+	_ resource.ResourceWithConfigure   = &ImageResource{}
+	_ resource.ResourceWithImportState = &ImageResource{}
+	_ resource.ResourceWithModifyPlan  = &ImageResource{}
+)
+
+// NewImageResource создает новый ресурс пользовательского образа
+func NewImageResource() resource.Resource {
+	return &ImageResource{}
+}
+
+// ImageResource - пользовательский образ проекта (из диска, снапшота или файла в бакете)
+type ImageResource struct {
+	client *client.Client
+}
+
+// ImageResourceModel - модель состояния ресурса
+type ImageResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ProjectID            types.String `tfsdk:"project_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	SourceDiskID         types.String `tfsdk:"source_disk_id"`
+	SourceSnapshotID     types.String `tfsdk:"source_snapshot_id"`
+	SourceBucketID       types.String `tfsdk:"source_bucket_id"`
+	SourceObjectKey      types.String `tfsdk:"source_object_key"`
+	SourceFormat         types.String `tfsdk:"source_format"`
+	OSFamily             types.String `tfsdk:"os_family"`
+	OSVersion            types.String `tfsdk:"os_version"`
+	Architecture         types.String `tfsdk:"architecture"`
+	SharedWithProjectIDs types.List   `tfsdk:"shared_with_project_ids"`
+	Status               types.String `tfsdk:"status"`
+	Size                 types.String `tfsdk:"size"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	Labels               types.Map    `tfsdk:"labels"`
+	LabelsAll            types.Map    `tfsdk:"labels_all"`
+}
+
+// Metadata возвращает метаданные ресурса
+func (r *ImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+// Schema определяет схему ресурса
+func (r *ImageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replaceString := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project-private custom image created from a disk, a snapshot or a qcow2/raw file stored in an `h3_s3_bucket`. The image `id` can be used as `h3_vm.image`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Image ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID (UUID) that owns the image",
+				Required:            true,
+				PlanModifiers:       replaceString,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Image name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Image description",
+				Optional:            true,
+			},
+			"source_disk_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the disk to create the image from. The disk should be detached or its VM stopped. Conflicts with `source_snapshot_id` and `source_bucket_id`",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"source_snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the disk snapshot to create the image from. Conflicts with `source_disk_id` and `source_bucket_id`",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"source_bucket_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the `h3_s3_bucket` holding the image file. Requires `source_object_key`; conflicts with `source_disk_id` and `source_snapshot_id`",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"source_object_key": schema.StringAttribute{
+				MarkdownDescription: "Object key of the image file in `source_bucket_id`",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"source_format": schema.StringAttribute{
+				MarkdownDescription: "Format of the uploaded file: `qcow2` or `raw` (default: `qcow2`). Only valid with `source_bucket_id`",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"os_family": schema.StringAttribute{
+				MarkdownDescription: "OS family, e.g. `ubuntu`. Detected by the backend when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"os_version": schema.StringAttribute{
+				MarkdownDescription: "OS version, e.g. `24.04`. Detected by the backend when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"architecture": schema.StringAttribute{
+				MarkdownDescription: "CPU architecture of the image",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shared_with_project_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of other projects allowed to boot VMs from this image. Updated in place",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Image status",
+				Computed:            true,
+			},
+			"size": schema.StringAttribute{
+				MarkdownDescription: "Image size",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels":     labels.Attribute(),
+			"labels_all": labels.AllAttribute(),
+		},
+	}
+}
+
+// Configure инициализирует ресурс с клиентом
+func (r *ImageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan вычисляет labels_all и проверяет источник образа
+func (r *ImageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ImageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ровно один источник: диск, снапшот или файл в бакете
+	sources := 0
+	for _, v := range []types.String{plan.SourceDiskID, plan.SourceSnapshotID, plan.SourceBucketID} {
+		if !v.IsNull() {
+			sources++
+		}
+	}
+	if sources != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid image source",
+			"Exactly one of source_disk_id, source_snapshot_id or source_bucket_id must be set",
+		)
+		return
+	}
+
+	if plan.SourceBucketID.IsNull() {
+		for _, attr := range []string{"source_object_key", "source_format"} {
+			var v types.String
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attr), &v)...)
+			if !v.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr),
+					"Invalid image source",
+					attr+" can only be set together with source_bucket_id",
+				)
+			}
+		}
+	} else if plan.SourceObjectKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_object_key"),
+			"Missing source_object_key",
+			"source_object_key is required when creating an image from source_bucket_id",
+		)
+	}
+
+	if !plan.SourceFormat.IsNull() && !plan.SourceFormat.IsUnknown() {
+		switch plan.SourceFormat.ValueString() {
+		case "qcow2", "raw":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_format"),
+				"Invalid source_format",
+				fmt.Sprintf("source_format must be qcow2 or raw, got %q", plan.SourceFormat.ValueString()),
+			)
+		}
+	}
+
+	if plan.SharedWithProjectIDs.IsUnknown() || plan.ProjectID.IsUnknown() {
+		return
+	}
+	shared, diags := listToStrings(ctx, plan.SharedWithProjectIDs)
+	resp.Diagnostics.Append(diags...)
+	for _, id := range shared {
+		if id == plan.ProjectID.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("shared_with_project_ids"),
+				"Invalid shared_with_project_ids",
+				"An image cannot be shared with its own project "+id,
+			)
+		}
+	}
+}
+
+// Create создает образ и ждет, пока он станет доступен для загрузки VM
+func (r *ImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ImageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	shared, diags := listToStrings(ctx, plan.SharedWithProjectIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := CreateImageRequest{
+		ProjectID:            plan.ProjectID.ValueString(),
+		Name:                 plan.Name.ValueString(),
+		Description:          plan.Description.ValueString(),
+		SourceDiskID:         plan.SourceDiskID.ValueString(),
+		SourceSnapshotID:     plan.SourceSnapshotID.ValueString(),
+		SourceBucketID:       plan.SourceBucketID.ValueString(),
+		SourceObjectKey:      plan.SourceObjectKey.ValueString(),
+		SourceFormat:         plan.SourceFormat.ValueString(),
+		OSFamily:             plan.OSFamily.ValueString(),
+		OSVersion:            plan.OSVersion.ValueString(),
+		SharedWithProjectIDs: shared,
+		Labels:               allLabels,
+	}
+	if !plan.SourceBucketID.IsNull() && createReq.SourceFormat == "" {
+		createReq.SourceFormat = "qcow2"
+	}
+
+	var image Image
+	err := r.client.Do(ctx, "POST", "/api/images/v1", nil, createReq, &image)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating image",
+			"Could not create image: "+err.Error(),
+		)
+		return
+	}
+
+	// Сохраняем ID сразу, чтобы образ не потерялся, если ожидание упадет
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(image.ID))...)
+
+	// Конвертация загруженного файла может занимать заметное время
+	image, err = r.waitForImage(ctx, image.ID, 60*time.Minute)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for image",
+			"Image created but not ready: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(image.ID)
+	setImageFromAPI(&plan, image)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read читает текущее состояние образа
+func (r *ImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ImageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var image Image
+	err := r.client.Do(ctx, "GET", "/api/images/v1/"+state.ID.ValueString(), nil, nil, &image)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading image", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(image.Name)
+	if image.ProjectID != "" {
+		state.ProjectID = types.StringValue(image.ProjectID)
+	}
+	if image.Description != "" || !state.Description.IsNull() {
+		state.Description = types.StringValue(image.Description)
+	}
+	// Источник берем из API только при импорте: backend может не возвращать его
+	if state.SourceDiskID.IsNull() && image.SourceDiskID != "" {
+		state.SourceDiskID = types.StringValue(image.SourceDiskID)
+	}
+	if state.SourceSnapshotID.IsNull() && image.SourceSnapshotID != "" {
+		state.SourceSnapshotID = types.StringValue(image.SourceSnapshotID)
+	}
+	if state.SourceBucketID.IsNull() && image.SourceBucketID != "" {
+		state.SourceBucketID = types.StringValue(image.SourceBucketID)
+		state.SourceObjectKey = types.StringValue(image.SourceObjectKey)
+	}
+
+	var diags diag.Diagnostics
+	state.SharedWithProjectIDs, diags = sharedFromAPI(ctx, image.SharedWithProjectIDs, state.SharedWithProjectIDs)
+	resp.Diagnostics.Append(diags...)
+
+	setImageFromAPI(&state, image)
+
+	state.Labels, diags = labels.FromAPI(ctx, image.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update обновляет имя, описание, список проектов с доступом и labels образа
+func (r *ImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ImageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updateReq UpdateImageRequest
+	changed := false
+
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+		changed = true
+	}
+	if !plan.Description.Equal(state.Description) {
+		description := plan.Description.ValueString()
+		updateReq.Description = &description
+		changed = true
+	}
+	if !plan.SharedWithProjectIDs.Equal(state.SharedWithProjectIDs) {
+		shared, diags := listToStrings(ctx, plan.SharedWithProjectIDs)
+		resp.Diagnostics.Append(diags...)
+		// Пустой список, а не nil: иначе backend не снимет доступ
+		if shared == nil {
+			shared = []string{}
+		}
+		updateReq.SharedWithProjectIDs = &shared
+		changed = true
+	}
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if allLabels == nil {
+			allLabels = map[string]string{}
+		}
+		updateReq.Labels = &allLabels
+		changed = true
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if changed {
+		var image Image
+		err := r.client.Do(ctx, "PATCH", "/api/images/v1/"+state.ID.ValueString(), nil, updateReq, &image)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating image",
+				"Could not update image: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.Status = state.Status
+	plan.Size = state.Size
+	plan.CreatedAt = state.CreatedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete удаляет образ
+func (r *ImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ImageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Do(ctx, "DELETE", "/api/images/v1/"+state.ID.ValueString(), nil, nil, nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting image", err.Error())
+	}
+}
+
+// ImportState импортирует образ по ID
+func (r *ImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForImage ждет, пока образ станет доступен для создания VM
+func (r *ImageResource) waitForImage(ctx context.Context, id string, timeout time.Duration) (Image, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return Image{}, fmt.Errorf("timeout waiting for image %s", id)
+		case <-ticker.C:
+			var image Image
+			if err := r.client.Do(ctx, "GET", "/api/images/v1/"+id, nil, nil, &image); err != nil {
+				return Image{}, err
+			}
+
+			log.Printf("[DEBUG] waitForImage: %s Status=%s", id, image.Status)
+
+			switch image.Status {
+			case "READY", "AVAILABLE", "ACTIVE":
+				return image, nil
+			case "FAILED", "ERROR":
+				if image.Message != "" {
+					return Image{}, fmt.Errorf("image creation failed: %s", image.Message)
+				}
+				return Image{}, fmt.Errorf("image creation failed")
+			}
+		}
+	}
+}
+
+// setImageFromAPI заполняет computed атрибуты образа из ответа API
+func setImageFromAPI(model *ImageResourceModel, image Image) {
+	model.OSFamily = types.StringValue(image.OSFamily)
+	model.OSVersion = types.StringValue(image.OSVersion)
+	model.Architecture = types.StringValue(image.Architecture)
+	model.Status = types.StringValue(image.Status)
+	model.Size = types.StringValue(image.Size)
+	model.CreatedAt = types.StringValue(image.CreatedAt)
+	model.LabelsAll = labels.Value(image.Labels)
+}
+
+// sharedFromAPI возвращает shared_with_project_ids, сохраняя порядок из state,
+// если набор проектов не изменился (backend может вернуть их в другом порядке)
+func sharedFromAPI(ctx context.Context, apiIDs []string, current types.List) (types.List, diag.Diagnostics) {
+	if len(apiIDs) == 0 && current.IsNull() {
+		return current, nil
+	}
+
+	currentIDs, diags := listToStrings(ctx, current)
+	if diags.HasError() {
+		return current, diags
+	}
+
+	a := append([]string(nil), apiIDs...)
+	b := append([]string(nil), currentIDs...)
+	sort.Strings(a)
+	sort.Strings(b)
+	if fmt.Sprint(a) == fmt.Sprint(b) && !current.IsNull() {
+		return current, diags
+	}
+
+	list, d := types.ListValueFrom(ctx, types.StringType, apiIDs)
+	diags.Append(d...)
+	return list, diags
+}
+
+// listToStrings конвертирует list строк в []string (null - nil)
+func listToStrings(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var out []string
+	diags := list.ElementsAs(ctx, &out, false)
+	return out, diags
+}
@@ -0,0 +1,223 @@
+package net
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &EIPDataSource{}
+	_ datasource.DataSourceWithConfigure = &EIPDataSource{}
+)
+
+func NewEIPDataSource() datasource.DataSource {
+	return &EIPDataSource{}
+}
+
+type EIPDataSource struct {
+	client *client.Client
+}
+
+type EIPDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	GatewayName types.String `tfsdk:"gateway_name"`
+	IPAddress   types.String `tfsdk:"ip_address"`
+	VMID        types.String `tfsdk:"vm_id"`
+	Status      types.String `tfsdk:"status"`
+	Labels      types.Map    `tfsdk:"labels"`
+}
+
+func (d *EIPDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ovn_eip"
+}
+
+func (d *EIPDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := eipDataSourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "EIP ID (conflicts with name)",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "EIP name (conflicts with id)",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID), required when looking up by name",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing H3 Cloud OVN Elastic IP by ID or by name within a project",
+		Attributes:          attrs,
+	}
+}
+
+func eipDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "EIP ID",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "EIP name",
+			Computed:            true,
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "Project ID (UUID)",
+			Computed:            true,
+		},
+		"gateway_name": schema.StringAttribute{
+			MarkdownDescription: "Gateway name in Kubernetes",
+			Computed:            true,
+		},
+		"ip_address": schema.StringAttribute{
+			MarkdownDescription: "Allocated IP address",
+			Computed:            true,
+		},
+		"vm_id": schema.StringAttribute{
+			MarkdownDescription: "Attached VM ID",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "EIP status (DETACHED, ATTACHED, PENDING, ERROR)",
+			Computed:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels attached to the EIP",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+func (d *EIPDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EIPDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config EIPDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+
+	if hasID == hasName {
+		resp.Diagnostics.AddError(
+			"Invalid EIP lookup",
+			"Exactly one of id or name must be provided",
+		)
+		return
+	}
+
+	var eip EIP
+	if hasID {
+		err := d.client.Do(ctx, "GET", "/api/ovn/v1/eips/"+config.ID.ValueString(), nil, nil, &eip)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading EIP", err.Error())
+			return
+		}
+	} else {
+		if config.ProjectID.IsNull() || config.ProjectID.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Missing project_id",
+				"project_id is required when looking up an EIP by name",
+			)
+			return
+		}
+
+		found, err := findEIPByName(ctx, d.client, config.ProjectID.ValueString(), config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up EIP", err.Error())
+			return
+		}
+		eip = *found
+	}
+
+	state := eipDataSourceModel(eip)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func eipDataSourceModel(eip EIP) EIPDataSourceModel {
+	return EIPDataSourceModel{
+		ID:          types.StringValue(eip.ID),
+		Name:        types.StringValue(eip.Name),
+		ProjectID:   types.StringValue(eip.ProjectID),
+		GatewayName: types.StringValue(eip.GatewayName),
+		IPAddress:   types.StringValue(eip.IPAddress),
+		VMID:        types.StringValue(eip.VMID),
+		Status:      types.StringValue(eip.Status),
+		Labels:      labels.Value(eip.Labels),
+	}
+}
+
+func listEIPs(ctx context.Context, c *client.Client, projectID string) ([]EIP, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	var eips []EIP
+	for {
+		var listResp EIPListResponse
+		if err := c.Do(ctx, "GET", "/api/ovn/v1/eips", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		eips = append(eips, listResp.EIPs...)
+		if listResp.NextPageToken == "" {
+			return eips, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+func findEIPByName(ctx context.Context, c *client.Client, projectID, name string) (*EIP, error) {
+	eips, err := listEIPs(ctx, c, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []EIP
+	for _, eip := range eips {
+		if eip.Name == name {
+			found = append(found, eip)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("EIP %q not found in project %s", name, projectID)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d EIPs named %q in project %s", len(found), name, projectID)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &EIPResource{}
 	_ resource.ResourceWithConfigure   = &EIPResource{}
 	_ resource.ResourceWithImportState = &EIPResource{}
+	_ resource.ResourceWithModifyPlan  = &EIPResource{}
 )
 
 func NewEIPResource() resource.Resource {
@@ -38,6 +41,8 @@ type EIPResourceModel struct {
 	IPAddress   types.String `tfsdk:"ip_address"`
 	VMID        types.String `tfsdk:"vm_id"`
 	Status      types.String `tfsdk:"status"`
+	Labels      types.Map    `tfsdk:"labels"`
+	LabelsAll   types.Map    `tfsdk:"labels_all"`
 }
 
 func (r *EIPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -94,6 +99,8 @@ func (r *EIPResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				MarkdownDescription: "EIP status (DETACHED, ATTACHED, PENDING, ERROR)",
 				Computed:            true,
 			},
+			"labels":     labels.Attribute(),
+			"labels_all": labels.AllAttribute(),
 		},
 	}
 }
@@ -115,6 +122,10 @@ func (r *EIPResource) Configure(ctx context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+func (r *EIPResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+}
+
 func (r *EIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan EIPResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -131,6 +142,13 @@ func (r *EIPResource) Create(ctx context.Context, req resource.CreateRequest, re
 		createReq.NetworkID = plan.NetworkID.ValueString()
 	}
 
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Labels = allLabels
+
 	var eip EIP
 	err := r.client.Do(ctx, "POST", "/api/ovn/v1/eips", nil, createReq, &eip)
 	if err != nil {
@@ -222,6 +240,11 @@ func (r *EIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	state.IPAddress = types.StringValue(eip.IPAddress)
 	state.GatewayName = types.StringValue(eip.GatewayName)
 
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, eip.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+	state.LabelsAll = labels.Value(eip.Labels)
+
 	if eip.VMID != "" {
 		state.VMID = types.StringValue(eip.VMID)
 	} else {
@@ -297,6 +320,24 @@ func (r *EIPResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		}
 	}
 
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updateReq := UpdateEIPRequest{Labels: allLabels}
+		err := r.client.Do(ctx, "PATCH", "/api/ovn/v1/eips/"+state.ID.ValueString(), nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating EIP labels",
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	var eip EIP
 	if err := r.client.Do(ctx, "GET", "/api/ovn/v1/eips/"+state.ID.ValueString(), nil, nil, &eip); err != nil {
 		resp.Diagnostics.AddError(
@@ -0,0 +1,131 @@
+package net
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &EIPsDataSource{}
+	_ datasource.DataSourceWithConfigure = &EIPsDataSource{}
+)
+
+func NewEIPsDataSource() datasource.DataSource {
+	return &EIPsDataSource{}
+}
+
+type EIPsDataSource struct {
+	client *client.Client
+}
+
+type EIPsDataSourceModel struct {
+	ProjectID types.String         `tfsdk:"project_id"`
+	NameRegex types.String         `tfsdk:"name_regex"`
+	Status    types.String         `tfsdk:"status"`
+	Attached  types.Bool           `tfsdk:"attached"`
+	Labels    types.Map            `tfsdk:"labels"`
+	IDs       types.List           `tfsdk:"ids"`
+	EIPs      []EIPDataSourceModel `tfsdk:"eips"`
+}
+
+func (d *EIPsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ovn_eips"
+}
+
+func (d *EIPsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := filter.Attributes(true)
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID)",
+		Required:            true,
+	}
+	attrs["attached"] = schema.BoolAttribute{
+		MarkdownDescription: "If set, only return EIPs that are (`true`) or are not (`false`) attached to a VM",
+		Optional:            true,
+	}
+	attrs["ids"] = schema.ListAttribute{
+		MarkdownDescription: "IDs of the matching OVN elastic IPs",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	attrs["eips"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Matching OVN elastic IPs",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: eipDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists H3 Cloud OVN elastic IPs in a project, optionally filtered by name regex, status, attachment and labels",
+		Attributes:          attrs,
+	}
+}
+
+func (d *EIPsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EIPsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config EIPsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, config.Status, config.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listEIPs(ctx, d.client, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing elastic IPs",
+			fmt.Sprintf("Could not list elastic IPs: %s", err.Error()),
+		)
+		return
+	}
+
+	ids := []string{}
+	config.EIPs = []EIPDataSourceModel{}
+	for _, eip := range items {
+		if !f.Match(eip.Name, eip.Status, eip.Labels) {
+			continue
+		}
+		if !config.Attached.IsNull() && config.Attached.ValueBool() != (eip.VMID != "") {
+			continue
+		}
+
+		item := eipDataSourceModel(eip)
+
+		ids = append(ids, eip.ID)
+		config.EIPs = append(config.EIPs, item)
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
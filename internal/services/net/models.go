@@ -1,10 +1,13 @@
 package net
 
 type CreateVPCRequest struct {
-	Name         string           `json:"name"`
-	ProjectID    string           `json:"project_id"`
-	Namespaces   []string         `json:"namespaces,omitempty"`
-	StaticRoutes []StaticRouteDTO `json:"static_routes,omitempty"`
+	Name         string            `json:"name"`
+	ProjectID    string            `json:"project_id"`
+	Namespaces   []string          `json:"namespaces,omitempty"`
+	StaticRoutes []StaticRouteDTO  `json:"static_routes,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+
+	DeletionProtection bool `json:"deletion_protection,omitempty"`
 }
 
 type StaticRouteDTO struct {
@@ -14,48 +17,70 @@ type StaticRouteDTO struct {
 }
 
 type VPC struct {
-	ID         string   `json:"id"`
-	K8sUID     string   `json:"k8sUid"`
-	Name       string   `json:"name"`
-	ProjectID  string   `json:"projectId"`
-	Namespace  string   `json:"namespace"`
-	Namespaces []string `json:"namespaces"`
-	Status     string   `json:"status"`
+	ID         string            `json:"id"`
+	K8sUID     string            `json:"k8sUid"`
+	Name       string            `json:"name"`
+	ProjectID  string            `json:"projectId"`
+	Namespace  string            `json:"namespace"`
+	Namespaces []string          `json:"namespaces"`
+	Status     string            `json:"status"`
+	Labels     map[string]string `json:"labels,omitempty"`
+
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+}
+
+type UpdateVPCRequest struct {
+	Labels             *map[string]string `json:"labels,omitempty"`
+	DeletionProtection *bool              `json:"deletion_protection,omitempty"`
 }
 
 type VPCListResponse struct {
-	VPCs []VPC `json:"vpcs"`
+	VPCs          []VPC  `json:"vpcs"`
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
 
 type CreateNetworkRequest struct {
-	Name            string   `json:"name"`
-	ProjectID       string   `json:"project_id"`
-	VPCID           string   `json:"vpc_id,omitempty"`
-	CIDRBlock       string   `json:"cidr_block"`
-	Protocol        string   `json:"protocol,omitempty"`
-	ExternalSubnets []string `json:"external_subnets,omitempty"`
+	Name            string            `json:"name"`
+	ProjectID       string            `json:"project_id"`
+	VPCID           string            `json:"vpc_id,omitempty"`
+	CIDRBlock       string            `json:"cidr_block"`
+	Protocol        string            `json:"protocol,omitempty"`
+	ExternalSubnets []string          `json:"external_subnets,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
 }
 
 type Network struct {
-	SubnetID    string `json:"subnet_id"`
-	SubnetName  string `json:"subnet_name"`
-	GatewayID   string `json:"gateway_id"`
-	GatewayName string `json:"gateway_name"`
-	VPCID       string `json:"vpc_id"`
-	VPCName     string `json:"vpc_name"`
-	CIDRBlock   string `json:"cidr_block"`
-	Protocol    string `json:"protocol"`
-	Status      string `json:"status"`
+	Name        string            `json:"name,omitempty"`
+	SubnetID    string            `json:"subnet_id"`
+	SubnetName  string            `json:"subnet_name"`
+	GatewayID   string            `json:"gateway_id"`
+	GatewayName string            `json:"gateway_name"`
+	VPCID       string            `json:"vpc_id"`
+	VPCName     string            `json:"vpc_name"`
+	CIDRBlock   string            `json:"cidr_block"`
+	Protocol    string            `json:"protocol"`
+	Status      string            `json:"status"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+type UpdateNetworkRequest struct {
+	Labels map[string]string `json:"labels"`
 }
 
 type NetworkListResponse struct {
-	Networks []Network `json:"networks"`
+	Networks      []Network `json:"networks"`
+	NextPageToken string    `json:"next_page_token,omitempty"`
 }
 
 type CreateEIPRequest struct {
-	Name      string `json:"name"`
-	ProjectID string `json:"project_id"`
-	NetworkID string `json:"network_id,omitempty"`
+	Name      string            `json:"name"`
+	ProjectID string            `json:"project_id"`
+	NetworkID string            `json:"network_id,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+type UpdateEIPRequest struct {
+	Labels map[string]string `json:"labels"`
 }
 
 type AttachEIPRequest struct {
@@ -69,19 +94,21 @@ type DetachEIPRequest struct {
 }
 
 type EIP struct {
-	ID          string `json:"id"`
-	K8sUID      string `json:"k8sUid"`
-	K8sName     string `json:"k8sName"`
-	Name        string `json:"name"`
-	ProjectID   string `json:"projectId"`
-	Namespace   string `json:"namespace"`
-	GatewayName string `json:"gatewayName"`
-	IPAddress   string `json:"ipAddress"`
-	VMID        string `json:"vmId"`
-	FIPName     string `json:"fipName"`
-	Status      string `json:"status"`
+	ID          string            `json:"id"`
+	K8sUID      string            `json:"k8sUid"`
+	K8sName     string            `json:"k8sName"`
+	Name        string            `json:"name"`
+	ProjectID   string            `json:"projectId"`
+	Namespace   string            `json:"namespace"`
+	GatewayName string            `json:"gatewayName"`
+	IPAddress   string            `json:"ipAddress"`
+	VMID        string            `json:"vmId"`
+	FIPName     string            `json:"fipName"`
+	Status      string            `json:"status"`
+	Labels      map[string]string `json:"labels,omitempty"`
 }
 
 type EIPListResponse struct {
-	EIPs []EIP `json:"eips"`
+	EIPs          []EIP  `json:"eips"`
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
@@ -0,0 +1,246 @@
+package net
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &NetworkDataSource{}
+	_ datasource.DataSourceWithConfigure = &NetworkDataSource{}
+)
+
+func NewNetworkDataSource() datasource.DataSource {
+	return &NetworkDataSource{}
+}
+
+type NetworkDataSource struct {
+	client *client.Client
+}
+
+type NetworkDataSourceModel struct {
+	SubnetID    types.String `tfsdk:"subnet_id"`
+	SubnetName  types.String `tfsdk:"subnet_name"`
+	GatewayID   types.String `tfsdk:"gateway_id"`
+	GatewayName types.String `tfsdk:"gateway_name"`
+	Name        types.String `tfsdk:"name"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	VPCID       types.String `tfsdk:"vpc_id"`
+	VPCName     types.String `tfsdk:"vpc_name"`
+	CIDRBlock   types.String `tfsdk:"cidr_block"`
+	Protocol    types.String `tfsdk:"protocol"`
+	Status      types.String `tfsdk:"status"`
+	Labels      types.Map    `tfsdk:"labels"`
+}
+
+func (d *NetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ovn_network"
+}
+
+func (d *NetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := networkDataSourceAttributes()
+	attrs["subnet_id"] = schema.StringAttribute{
+		MarkdownDescription: "Subnet ID (conflicts with name)",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "Network name (conflicts with subnet_id)",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID), required when looking up by name",
+		Optional:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing H3 Cloud OVN Network (Subnet + Gateway) by subnet ID or by name within a project",
+		Attributes:          attrs,
+	}
+}
+
+func networkDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"subnet_id": schema.StringAttribute{
+			MarkdownDescription: "Subnet ID",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Network name",
+			Computed:            true,
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "Project ID (UUID)",
+			Computed:            true,
+		},
+		"subnet_name": schema.StringAttribute{
+			MarkdownDescription: "Subnet Kubernetes name",
+			Computed:            true,
+		},
+		"gateway_id": schema.StringAttribute{
+			MarkdownDescription: "Gateway ID",
+			Computed:            true,
+		},
+		"gateway_name": schema.StringAttribute{
+			MarkdownDescription: "Gateway Kubernetes name",
+			Computed:            true,
+		},
+		"vpc_id": schema.StringAttribute{
+			MarkdownDescription: "VPC ID",
+			Computed:            true,
+		},
+		"vpc_name": schema.StringAttribute{
+			MarkdownDescription: "VPC Kubernetes name",
+			Computed:            true,
+		},
+		"cidr_block": schema.StringAttribute{
+			MarkdownDescription: "CIDR block",
+			Computed:            true,
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "IP protocol (IPv4, IPv6, Dual)",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Network status",
+			Computed:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels attached to the network",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+func (d *NetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config NetworkDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.SubnetID.IsNull() && config.SubnetID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+
+	if hasID == hasName {
+		resp.Diagnostics.AddError(
+			"Invalid Network lookup",
+			"Exactly one of subnet_id or name must be provided",
+		)
+		return
+	}
+
+	var network Network
+	if hasID {
+		err := d.client.Do(ctx, "GET", "/api/ovn/v1/networks/"+config.SubnetID.ValueString(), nil, nil, &network)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading Network", err.Error())
+			return
+		}
+	} else {
+		if config.ProjectID.IsNull() || config.ProjectID.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Missing project_id",
+				"project_id is required when looking up a Network by name",
+			)
+			return
+		}
+
+		found, err := findNetworkByName(ctx, d.client, config.ProjectID.ValueString(), config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up Network", err.Error())
+			return
+		}
+		network = *found
+	}
+
+	state := networkDataSourceModel(network)
+	state.ProjectID = config.ProjectID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func networkDataSourceModel(network Network) NetworkDataSourceModel {
+	return NetworkDataSourceModel{
+		SubnetID:    types.StringValue(network.SubnetID),
+		SubnetName:  types.StringValue(network.SubnetName),
+		GatewayID:   types.StringValue(network.GatewayID),
+		GatewayName: types.StringValue(network.GatewayName),
+		Name:        types.StringValue(network.Name),
+		VPCID:       types.StringValue(network.VPCID),
+		VPCName:     types.StringValue(network.VPCName),
+		CIDRBlock:   types.StringValue(network.CIDRBlock),
+		Protocol:    types.StringValue(network.Protocol),
+		Status:      types.StringValue(network.Status),
+		Labels:      labels.Value(network.Labels),
+	}
+}
+
+func listNetworks(ctx context.Context, c *client.Client, projectID string) ([]Network, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	var networks []Network
+	for {
+		var listResp NetworkListResponse
+		if err := c.Do(ctx, "GET", "/api/ovn/v1/networks", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		networks = append(networks, listResp.Networks...)
+		if listResp.NextPageToken == "" {
+			return networks, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+func findNetworkByName(ctx context.Context, c *client.Client, projectID, name string) (*Network, error) {
+	networks, err := listNetworks(ctx, c, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Network
+	for _, network := range networks {
+		if network.Name == name {
+			found = append(found, network)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("Network %q not found in project %s", name, projectID)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d Networks named %q in project %s", len(found), name, projectID)
+	}
+}
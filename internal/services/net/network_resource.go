@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +22,7 @@ var (
 	_ resource.Resource                = &NetworkResource{}
 	_ resource.ResourceWithConfigure   = &NetworkResource{}
 	_ resource.ResourceWithImportState = &NetworkResource{}
+	_ resource.ResourceWithModifyPlan  = &NetworkResource{}
 )
 
 func NewNetworkResource() resource.Resource {
@@ -43,6 +46,8 @@ type NetworkResourceModel struct {
 	Protocol        types.String `tfsdk:"protocol"`
 	ExternalSubnets types.List   `tfsdk:"external_subnets"`
 	Status          types.String `tfsdk:"status"`
+	Labels          types.Map    `tfsdk:"labels"`
+	LabelsAll       types.Map    `tfsdk:"labels_all"`
 }
 
 func (r *NetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -121,6 +126,7 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
 					listplanmodifier.RequiresReplace(),
 				},
 			},
@@ -128,6 +134,8 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "Network status",
 				Computed:            true,
 			},
+			"labels":     labels.Attribute(),
+			"labels_all": labels.AllAttribute(),
 		},
 	}
 }
@@ -149,6 +157,10 @@ func (r *NetworkResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *NetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+}
+
 func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan NetworkResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -179,6 +191,13 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 		createReq.ExternalSubnets = externalSubnets
 	}
 
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Labels = allLabels
+
 	var network Network
 	err := r.client.Do(ctx, "POST", "/api/ovn/v1/networks", nil, createReq, &network)
 	if err != nil {
@@ -242,14 +261,58 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.GatewayID = types.StringValue(network.GatewayID)
 	state.GatewayName = types.StringValue(network.GatewayName)
 
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, network.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+	state.LabelsAll = labels.Value(network.Labels)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Network resources cannot be updated. All changes require resource replacement.",
-	)
+	var plan, state NetworkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Все остальные атрибуты требуют замены ресурса, in-place меняются только labels
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updateReq := UpdateNetworkRequest{Labels: allLabels}
+		err := r.client.Do(ctx, "PATCH", "/api/ovn/v1/networks/"+state.SubnetID.ValueString(), nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Network",
+				"Could not update Network labels: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var network Network
+	if err := r.client.Do(ctx, "GET", "/api/ovn/v1/networks/"+state.SubnetID.ValueString(), nil, nil, &network); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Network after update",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.SubnetID = state.SubnetID
+	plan.SubnetName = state.SubnetName
+	plan.VPCName = state.VPCName
+	plan.GatewayID = types.StringValue(network.GatewayID)
+	plan.GatewayName = types.StringValue(network.GatewayName)
+	plan.Status = types.StringValue(network.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
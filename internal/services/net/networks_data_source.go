@@ -0,0 +1,124 @@
+package net
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &NetworksDataSource{}
+	_ datasource.DataSourceWithConfigure = &NetworksDataSource{}
+)
+
+func NewNetworksDataSource() datasource.DataSource {
+	return &NetworksDataSource{}
+}
+
+type NetworksDataSource struct {
+	client *client.Client
+}
+
+type NetworksDataSourceModel struct {
+	ProjectID types.String             `tfsdk:"project_id"`
+	NameRegex types.String             `tfsdk:"name_regex"`
+	Status    types.String             `tfsdk:"status"`
+	Labels    types.Map                `tfsdk:"labels"`
+	IDs       types.List               `tfsdk:"ids"`
+	Networks  []NetworkDataSourceModel `tfsdk:"networks"`
+}
+
+func (d *NetworksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ovn_networks"
+}
+
+func (d *NetworksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := filter.Attributes(true)
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID)",
+		Required:            true,
+	}
+	attrs["ids"] = schema.ListAttribute{
+		MarkdownDescription: "IDs of the matching OVN networks",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	attrs["networks"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Matching OVN networks",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: networkDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists H3 Cloud OVN networks in a project, optionally filtered by name regex, status and labels",
+		Attributes:          attrs,
+	}
+}
+
+func (d *NetworksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config NetworksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, config.Status, config.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listNetworks(ctx, d.client, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing networks",
+			fmt.Sprintf("Could not list networks: %s", err.Error()),
+		)
+		return
+	}
+
+	ids := []string{}
+	config.Networks = []NetworkDataSourceModel{}
+	for _, network := range items {
+		if !f.Match(network.Name, network.Status, network.Labels) {
+			continue
+		}
+
+		item := networkDataSourceModel(network)
+		item.ProjectID = config.ProjectID
+
+		ids = append(ids, network.SubnetID)
+		config.Networks = append(config.Networks, item)
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
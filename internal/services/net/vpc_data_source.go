@@ -0,0 +1,224 @@
+package net
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &VPCDataSource{}
+	_ datasource.DataSourceWithConfigure = &VPCDataSource{}
+)
+
+func NewVPCDataSource() datasource.DataSource {
+	return &VPCDataSource{}
+}
+
+type VPCDataSource struct {
+	client *client.Client
+}
+
+type VPCDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectID  types.String `tfsdk:"project_id"`
+	Name       types.String `tfsdk:"name"`
+	Namespaces types.List   `tfsdk:"namespaces"`
+	Status     types.String `tfsdk:"status"`
+	Labels     types.Map    `tfsdk:"labels"`
+}
+
+func (d *VPCDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ovn_vpc"
+}
+
+func (d *VPCDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := vpcDataSourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "VPC ID (conflicts with name)",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID), required when looking up by name",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "VPC name (conflicts with id)",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing H3 Cloud OVN VPC by ID or by name within a project",
+		Attributes:          attrs,
+	}
+}
+
+func vpcDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "VPC ID",
+			Computed:            true,
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "Project ID (UUID)",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "VPC name",
+			Computed:            true,
+		},
+		"namespaces": schema.ListAttribute{
+			MarkdownDescription: "List of namespaces attached to VPC",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "VPC status",
+			Computed:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels attached to the VPC",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+func (d *VPCDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VPCDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config VPCDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+
+	if hasID == hasName {
+		resp.Diagnostics.AddError(
+			"Invalid VPC lookup",
+			"Exactly one of id or name must be provided",
+		)
+		return
+	}
+
+	var vpc VPC
+	if hasID {
+		err := d.client.Do(ctx, "GET", "/api/ovn/v1/vpcs/"+config.ID.ValueString(), nil, nil, &vpc)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading VPC", err.Error())
+			return
+		}
+	} else {
+		if config.ProjectID.IsNull() || config.ProjectID.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Missing project_id",
+				"project_id is required when looking up a VPC by name",
+			)
+			return
+		}
+
+		found, err := findVPCByName(ctx, d.client, config.ProjectID.ValueString(), config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up VPC", err.Error())
+			return
+		}
+		vpc = *found
+	}
+
+	state, diags := vpcDataSourceModel(vpc)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func vpcDataSourceModel(vpc VPC) (VPCDataSourceModel, diag.Diagnostics) {
+	namespaces := make([]attr.Value, 0, len(vpc.Namespaces))
+	for _, ns := range vpc.Namespaces {
+		namespaces = append(namespaces, types.StringValue(ns))
+	}
+	namespacesList, diags := types.ListValue(types.StringType, namespaces)
+
+	return VPCDataSourceModel{
+		ID:         types.StringValue(vpc.ID),
+		ProjectID:  types.StringValue(vpc.ProjectID),
+		Name:       types.StringValue(vpc.Name),
+		Namespaces: namespacesList,
+		Status:     types.StringValue(vpc.Status),
+		Labels:     labels.Value(vpc.Labels),
+	}, diags
+}
+
+func listVPCs(ctx context.Context, c *client.Client, projectID string) ([]VPC, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	var vpcs []VPC
+	for {
+		var listResp VPCListResponse
+		if err := c.Do(ctx, "GET", "/api/ovn/v1/vpcs", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		vpcs = append(vpcs, listResp.VPCs...)
+		if listResp.NextPageToken == "" {
+			return vpcs, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+func findVPCByName(ctx context.Context, c *client.Client, projectID, name string) (*VPC, error) {
+	vpcs, err := listVPCs(ctx, c, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []VPC
+	for _, vpc := range vpcs {
+		if vpc.Name == name {
+			found = append(found, vpc)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("VPC %q not found in project %s", name, projectID)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d VPCs named %q in project %s", len(found), name, projectID)
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"time"
 
 	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+	"h3terraform/internal/pkg/protection"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +23,7 @@ var (
 	_ resource.Resource                = &VPCResource{}
 	_ resource.ResourceWithConfigure   = &VPCResource{}
 	_ resource.ResourceWithImportState = &VPCResource{}
+	_ resource.ResourceWithModifyPlan  = &VPCResource{}
 )
 
 func NewVPCResource() resource.Resource {
@@ -31,12 +35,15 @@ type VPCResource struct {
 }
 
 type VPCResourceModel struct {
-	ID           types.String       `tfsdk:"id"`
-	ProjectID    types.String       `tfsdk:"project_id"`
-	Name         types.String       `tfsdk:"name"`
-	Namespaces   types.List         `tfsdk:"namespaces"`
-	StaticRoutes []StaticRouteModel `tfsdk:"static_routes"`
-	Status       types.String       `tfsdk:"status"`
+	ID                 types.String       `tfsdk:"id"`
+	ProjectID          types.String       `tfsdk:"project_id"`
+	Name               types.String       `tfsdk:"name"`
+	Namespaces         types.List         `tfsdk:"namespaces"`
+	StaticRoutes       []StaticRouteModel `tfsdk:"static_routes"`
+	Status             types.String       `tfsdk:"status"`
+	Labels             types.Map          `tfsdk:"labels"`
+	LabelsAll          types.Map          `tfsdk:"labels_all"`
+	DeletionProtection types.Bool         `tfsdk:"deletion_protection"`
 }
 
 type StaticRouteModel struct {
@@ -80,6 +87,7 @@ func (r *VPCResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
 					listplanmodifier.RequiresReplace(),
 				},
 			},
@@ -100,6 +108,9 @@ func (r *VPCResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							MarkdownDescription: "Routing policy",
 							Optional:            true,
 							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
 						},
 					},
 				},
@@ -111,6 +122,9 @@ func (r *VPCResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				MarkdownDescription: "VPC status",
 				Computed:            true,
 			},
+			"labels":              labels.Attribute(),
+			"labels_all":          labels.AllAttribute(),
+			"deletion_protection": protection.Attribute(),
 		},
 	}
 }
@@ -132,6 +146,10 @@ func (r *VPCResource) Configure(ctx context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+func (r *VPCResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+}
+
 func (r *VPCResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan VPCResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -165,6 +183,14 @@ func (r *VPCResource) Create(ctx context.Context, req resource.CreateRequest, re
 		createReq.StaticRoutes = staticRoutes
 	}
 
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Labels = allLabels
+	createReq.DeletionProtection = plan.DeletionProtection.ValueBool()
+
 	var vpc VPC
 	err := r.client.Do(ctx, "POST", "/api/ovn/v1/vpcs", nil, createReq, &vpc)
 	if err != nil {
@@ -217,14 +243,62 @@ func (r *VPCResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	state.Status = types.StringValue(vpc.Status)
 
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, vpc.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+	state.LabelsAll = labels.Value(vpc.Labels)
+	state.DeletionProtection = protection.FromAPI(vpc.DeletionProtection, state.DeletionProtection)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *VPCResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"VPC resources cannot be updated. All changes require resource replacement.",
-	)
+	var plan, state VPCResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Все остальные атрибуты требуют замены ресурса, in-place меняются только labels и deletion_protection
+	var updateReq UpdateVPCRequest
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Labels = &allLabels
+	}
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		protected := plan.DeletionProtection.ValueBool()
+		updateReq.DeletionProtection = &protected
+	}
+
+	if updateReq.Labels != nil || updateReq.DeletionProtection != nil {
+		err := r.client.Do(ctx, "PATCH", "/api/ovn/v1/vpcs/"+state.ID.ValueString(), nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating VPC",
+				"Could not update VPC: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var vpc VPC
+	if err := r.client.Do(ctx, "GET", "/api/ovn/v1/vpcs/"+state.ID.ValueString(), nil, nil, &vpc); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading VPC after update",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Status = types.StringValue(vpc.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *VPCResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -234,6 +308,11 @@ func (r *VPCResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	resp.Diagnostics.Append(protection.CheckDelete(state.DeletionProtection, "VPC", state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.Do(ctx, "DELETE", "/api/ovn/v1/vpcs/"+state.ID.ValueString(), nil, nil, nil)
 	if err != nil {
 		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
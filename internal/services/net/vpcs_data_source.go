@@ -0,0 +1,127 @@
+package net
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &VPCsDataSource{}
+	_ datasource.DataSourceWithConfigure = &VPCsDataSource{}
+)
+
+func NewVPCsDataSource() datasource.DataSource {
+	return &VPCsDataSource{}
+}
+
+type VPCsDataSource struct {
+	client *client.Client
+}
+
+type VPCsDataSourceModel struct {
+	ProjectID types.String         `tfsdk:"project_id"`
+	NameRegex types.String         `tfsdk:"name_regex"`
+	Status    types.String         `tfsdk:"status"`
+	Labels    types.Map            `tfsdk:"labels"`
+	IDs       types.List           `tfsdk:"ids"`
+	VPCs      []VPCDataSourceModel `tfsdk:"vpcs"`
+}
+
+func (d *VPCsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ovn_vpcs"
+}
+
+func (d *VPCsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := filter.Attributes(true)
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID)",
+		Required:            true,
+	}
+	attrs["ids"] = schema.ListAttribute{
+		MarkdownDescription: "IDs of the matching OVN VPCs",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	attrs["vpcs"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Matching OVN VPCs",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: vpcDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists H3 Cloud OVN VPCs in a project, optionally filtered by name regex, status and labels",
+		Attributes:          attrs,
+	}
+}
+
+func (d *VPCsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VPCsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config VPCsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, config.Status, config.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listVPCs(ctx, d.client, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing VPCs",
+			fmt.Sprintf("Could not list VPCs: %s", err.Error()),
+		)
+		return
+	}
+
+	ids := []string{}
+	config.VPCs = []VPCDataSourceModel{}
+	for _, vpc := range items {
+		if !f.Match(vpc.Name, vpc.Status, vpc.Labels) {
+			continue
+		}
+
+		item, diags := vpcDataSourceModel(vpc)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		ids = append(ids, vpc.ID)
+		config.VPCs = append(config.VPCs, item)
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
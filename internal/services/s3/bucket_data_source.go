@@ -0,0 +1,225 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &BucketDataSource{}
+	_ datasource.DataSourceWithConfigure = &BucketDataSource{}
+)
+
+func NewBucketDataSource() datasource.DataSource {
+	return &BucketDataSource{}
+}
+
+type BucketDataSource struct {
+	client *client.Client
+}
+
+type BucketDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Region      types.String `tfsdk:"region"`
+	IsPublic    types.Bool   `tfsdk:"is_public"`
+	Versioning  types.Bool   `tfsdk:"versioning"`
+	SizeBytes   types.Int64  `tfsdk:"size_bytes"`
+	ObjectCount types.Int64  `tfsdk:"object_count"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Labels      types.Map    `tfsdk:"labels"`
+}
+
+func (d *BucketDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_bucket"
+}
+
+func (d *BucketDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := bucketDataSourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "Bucket ID (conflicts with name)",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID)",
+		Required:            true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "Bucket name (conflicts with id)",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing H3 Cloud S3 Bucket by ID or by name within a project",
+		Attributes:          attrs,
+	}
+}
+
+func bucketDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Bucket ID",
+			Computed:            true,
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "Project ID (UUID)",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Bucket name",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "Bucket slug",
+			Computed:            true,
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "Bucket region",
+			Computed:            true,
+		},
+		"is_public": schema.BoolAttribute{
+			MarkdownDescription: "Whether the bucket is publicly readable",
+			Computed:            true,
+		},
+		"versioning": schema.BoolAttribute{
+			MarkdownDescription: "Whether object versioning is enabled",
+			Computed:            true,
+		},
+		"size_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Total size of stored objects in bytes",
+			Computed:            true,
+		},
+		"object_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of stored objects",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Bucket creation timestamp",
+			Computed:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels attached to the bucket",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+func (d *BucketDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BucketDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+
+	if hasID == hasName {
+		resp.Diagnostics.AddError(
+			"Invalid bucket lookup",
+			"Exactly one of id or name must be provided",
+		)
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+
+	var bucket *Bucket
+	var err error
+	if hasID {
+		bucket, err = findBucketByID(ctx, d.client, projectID, config.ID.ValueString())
+	} else {
+		bucket, err = getBucket(ctx, d.client, projectID, config.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading bucket",
+			fmt.Sprintf("Could not read bucket: %s", err.Error()),
+		)
+		return
+	}
+
+	state := bucketDataSourceModel(*bucket)
+	state.ProjectID = config.ProjectID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func bucketDataSourceModel(bucket Bucket) BucketDataSourceModel {
+	return BucketDataSourceModel{
+		ID:          types.StringValue(bucket.ID),
+		Name:        types.StringValue(bucket.Name),
+		Slug:        types.StringValue(bucket.Slug),
+		Region:      types.StringValue(bucket.Region),
+		IsPublic:    types.BoolValue(bucket.IsPublic),
+		Versioning:  types.BoolValue(bucket.Versioning),
+		SizeBytes:   types.Int64Value(bucket.SizeBytes),
+		ObjectCount: types.Int64Value(bucket.ObjectCount),
+		CreatedAt:   types.StringValue(bucket.CreatedAt),
+		Labels:      labels.Value(bucket.Labels),
+	}
+}
+
+func listBuckets(ctx context.Context, c *client.Client, projectID string) ([]Bucket, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	var buckets []Bucket
+	for {
+		var listResp ListBucketsResponse
+		if err := c.Do(ctx, "GET", "/api/s3/v1/buckets", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		buckets = append(buckets, listResp.Buckets...)
+		if listResp.NextPageToken == "" {
+			return buckets, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+func findBucketByID(ctx context.Context, c *client.Client, projectID, bucketID string) (*Bucket, error) {
+	buckets, err := listBuckets(ctx, c, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bucket := range buckets {
+		if bucket.ID == bucketID {
+			return &bucket, nil
+		}
+	}
+
+	return nil, fmt.Errorf("bucket %s not found in project %s", bucketID, projectID)
+}
@@ -6,7 +6,10 @@ import (
 	"time"
 
 	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+	"h3terraform/internal/pkg/protection"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +22,7 @@ var (
 	_ resource.Resource                = &BucketResource{}
 	_ resource.ResourceWithConfigure   = &BucketResource{}
 	_ resource.ResourceWithImportState = &BucketResource{}
+	_ resource.ResourceWithModifyPlan  = &BucketResource{}
 )
 
 func NewBucketResource() resource.Resource {
@@ -30,14 +34,17 @@ type BucketResource struct {
 }
 
 type BucketResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	ProjectID       types.String `tfsdk:"project_id"`
-	Name            types.String `tfsdk:"name"`
-	Slug            types.String `tfsdk:"slug"`
-	Region          types.String `tfsdk:"region"`
-	AccessKeyID     types.String `tfsdk:"access_key_id"`
-	SecretAccessKey types.String `tfsdk:"secret_access_key"`
-	CreatedAt       types.String `tfsdk:"created_at"`
+	ID                 types.String `tfsdk:"id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	Name               types.String `tfsdk:"name"`
+	Slug               types.String `tfsdk:"slug"`
+	Region             types.String `tfsdk:"region"`
+	AccessKeyID        types.String `tfsdk:"access_key_id"`
+	SecretAccessKey    types.String `tfsdk:"secret_access_key"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Labels             types.Map    `tfsdk:"labels"`
+	LabelsAll          types.Map    `tfsdk:"labels_all"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (r *BucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -91,6 +98,9 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "Bucket creation timestamp",
 				Computed:            true,
 			},
+			"labels":              labels.Attribute(),
+			"labels_all":          labels.AllAttribute(),
+			"deletion_protection": protection.Attribute(),
 		},
 	}
 }
@@ -112,6 +122,10 @@ func (r *BucketResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+func (r *BucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+}
+
 func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan BucketResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -124,6 +138,14 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		Name:      plan.Name.ValueString(),
 	}
 
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Labels = allLabels
+	createReq.DeletionProtection = plan.DeletionProtection.ValueBool()
+
 	var createResp CreateBucketResponse
 	err := r.client.Do(ctx, "POST", "/api/s3/v1/buckets", nil, createReq, &createResp)
 	if err != nil {
@@ -136,7 +158,7 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	time.Sleep(3 * time.Second)
 
-	bucket, err := r.getBucket(ctx, plan.ProjectID.ValueString(), plan.Name.ValueString())
+	bucket, err := getBucket(ctx, r.client, plan.ProjectID.ValueString(), plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading bucket",
@@ -162,7 +184,7 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	bucket, err := r.getBucket(ctx, state.ProjectID.ValueString(), state.Name.ValueString())
+	bucket, err := getBucket(ctx, r.client, state.ProjectID.ValueString(), state.Name.ValueString())
 	if err != nil {
 		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == 404 {
 			resp.State.RemoveResource(ctx)
@@ -180,14 +202,62 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.Region = types.StringValue(bucket.Region)
 	state.CreatedAt = types.StringValue(bucket.CreatedAt)
 
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, bucket.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+	state.LabelsAll = labels.Value(bucket.Labels)
+	state.DeletionProtection = protection.FromAPI(bucket.DeletionProtection, state.DeletionProtection)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Bucket resources cannot be updated. They must be replaced.",
-	)
+	var plan, state BucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// name и project_id требуют замены ресурса, in-place меняются только labels и deletion_protection
+	var updateReq UpdateBucketRequest
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Labels = &allLabels
+	}
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		protected := plan.DeletionProtection.ValueBool()
+		updateReq.DeletionProtection = &protected
+	}
+
+	if updateReq.Labels != nil || updateReq.DeletionProtection != nil {
+		queryParams := map[string]string{
+			"project_id": state.ProjectID.ValueString(),
+		}
+
+		err := r.client.Do(ctx, "PATCH", "/api/s3/v1/buckets/"+state.Name.ValueString(), queryParams, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating bucket",
+				fmt.Sprintf("Could not update bucket: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	// Credentials возвращаются только при создании, берем их из state
+	plan.ID = state.ID
+	plan.Slug = state.Slug
+	plan.Region = state.Region
+	plan.AccessKeyID = state.AccessKeyID
+	plan.SecretAccessKey = state.SecretAccessKey
+	plan.CreatedAt = state.CreatedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -197,6 +267,11 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(protection.CheckDelete(state.DeletionProtection, "bucket", state.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	queryParams := map[string]string{
 		"project_id": state.ProjectID.ValueString(),
 	}
@@ -218,13 +293,13 @@ func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *BucketResource) getBucket(ctx context.Context, projectID, bucketName string) (*Bucket, error) {
+func getBucket(ctx context.Context, c *client.Client, projectID, bucketName string) (*Bucket, error) {
 	queryParams := map[string]string{
 		"project_id": projectID,
 	}
 
 	var getBucketResp GetBucketResponse
-	err := r.client.Do(ctx, "GET", "/api/s3/v1/buckets/"+bucketName, queryParams, nil, &getBucketResp)
+	err := c.Do(ctx, "GET", "/api/s3/v1/buckets/"+bucketName, queryParams, nil, &getBucketResp)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,123 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &BucketsDataSource{}
+	_ datasource.DataSourceWithConfigure = &BucketsDataSource{}
+)
+
+func NewBucketsDataSource() datasource.DataSource {
+	return &BucketsDataSource{}
+}
+
+type BucketsDataSource struct {
+	client *client.Client
+}
+
+type BucketsDataSourceModel struct {
+	ProjectID types.String            `tfsdk:"project_id"`
+	NameRegex types.String            `tfsdk:"name_regex"`
+	Labels    types.Map               `tfsdk:"labels"`
+	IDs       types.List              `tfsdk:"ids"`
+	Buckets   []BucketDataSourceModel `tfsdk:"buckets"`
+}
+
+func (d *BucketsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_buckets"
+}
+
+func (d *BucketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := filter.Attributes(false)
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID)",
+		Required:            true,
+	}
+	attrs["ids"] = schema.ListAttribute{
+		MarkdownDescription: "IDs of the matching S3 buckets",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	attrs["buckets"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Matching S3 buckets",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: bucketDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists H3 Cloud S3 buckets in a project, optionally filtered by name regex and labels",
+		Attributes:          attrs,
+	}
+}
+
+func (d *BucketsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BucketsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, types.StringNull(), config.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listBuckets(ctx, d.client, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing buckets",
+			fmt.Sprintf("Could not list buckets: %s", err.Error()),
+		)
+		return
+	}
+
+	ids := []string{}
+	config.Buckets = []BucketDataSourceModel{}
+	for _, bucket := range items {
+		if !f.Match(bucket.Name, "", bucket.Labels) {
+			continue
+		}
+
+		item := bucketDataSourceModel(bucket)
+		item.ProjectID = config.ProjectID
+
+		ids = append(ids, bucket.ID)
+		config.Buckets = append(config.Buckets, item)
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
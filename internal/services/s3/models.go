@@ -1,8 +1,15 @@
 package s3
 
 type CreateBucketRequest struct {
-	ProjectID string `json:"project_id"`
-	Name      string `json:"name"`
+	ProjectID          string            `json:"project_id"`
+	Name               string            `json:"name"`
+	Labels             map[string]string `json:"labels,omitempty"`
+	DeletionProtection bool              `json:"deletion_protection,omitempty"`
+}
+
+type UpdateBucketRequest struct {
+	Labels             *map[string]string `json:"labels,omitempty"`
+	DeletionProtection *bool              `json:"deletion_protection,omitempty"`
 }
 
 type CreateBucketResponse struct {
@@ -16,20 +23,24 @@ type Credentials struct {
 }
 
 type ListBucketsResponse struct {
-	Buckets []Bucket `json:"buckets"`
+	Buckets       []Bucket `json:"buckets"`
+	NextPageToken string   `json:"next_page_token,omitempty"`
 }
 
 type Bucket struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Slug        string `json:"slug"`
-	Region      string `json:"region"`
-	IsPublic    bool   `json:"isPublic"`
-	Versioning  bool   `json:"versioning"`
-	SizeBytes   int64  `json:"sizeBytes"`
-	ObjectCount int64  `json:"objectCount"`
-	CreatedAt   string `json:"createdAt"`
-	UpdatedAt   string `json:"updatedAt"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Slug        string            `json:"slug"`
+	Region      string            `json:"region"`
+	IsPublic    bool              `json:"isPublic"`
+	Versioning  bool              `json:"versioning"`
+	SizeBytes   int64             `json:"sizeBytes"`
+	ObjectCount int64             `json:"objectCount"`
+	CreatedAt   string            `json:"createdAt"`
+	UpdatedAt   string            `json:"updatedAt"`
+	Labels      map[string]string `json:"labels,omitempty"`
+
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
 }
 
 type GetBucketResponse struct {
@@ -0,0 +1,206 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SnapshotDataSource{}
+var _ datasource.DataSourceWithConfigure = &SnapshotDataSource{}
+
+func NewSnapshotDataSource() datasource.DataSource {
+	return &SnapshotDataSource{}
+}
+
+type SnapshotDataSource struct {
+	client *client.Client
+}
+
+type SnapshotDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	DiskID    types.String `tfsdk:"disk_id"`
+	Name      types.String `tfsdk:"name"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Status    types.String `tfsdk:"status"`
+	Size      types.String `tfsdk:"size"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Labels    types.Map    `tfsdk:"labels"`
+}
+
+func (d *SnapshotDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot"
+}
+
+func (d *SnapshotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := snapshotDataSourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Snapshot ID (conflicts with name)",
+	}
+	attrs["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Snapshot name (conflicts with id)",
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Project ID",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing disk snapshot by ID or by name within a project",
+		Attributes:          attrs,
+	}
+}
+
+func snapshotDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Snapshot ID",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Snapshot name",
+			Computed:            true,
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "Project ID",
+			Computed:            true,
+		},
+		"disk_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Disk ID",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Snapshot status",
+		},
+		"size": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Snapshot size",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Creation timestamp",
+		},
+		"labels": schema.MapAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "Labels attached to the snapshot",
+		},
+	}
+}
+
+func (d *SnapshotDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *SnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SnapshotDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+
+	if hasID == hasName {
+		resp.Diagnostics.AddError("Invalid snapshot lookup", "Exactly one of id or name must be provided")
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+
+	var snapshot Snapshot
+	if hasID {
+		queryParams := map[string]string{
+			"project_id": projectID,
+		}
+
+		err := d.client.Do(ctx, "GET", "/api/disks/v1/snapshots/"+config.ID.ValueString(), queryParams, nil, &snapshot)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading snapshot", err.Error())
+			return
+		}
+	} else {
+		found, err := findSnapshotByName(ctx, d.client, projectID, config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up snapshot", err.Error())
+			return
+		}
+		snapshot = *found
+	}
+
+	state := snapshotDataSourceModel(snapshot)
+	state.ProjectID = config.ProjectID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func snapshotDataSourceModel(snapshot Snapshot) SnapshotDataSourceModel {
+	return SnapshotDataSourceModel{
+		ID:        types.StringValue(snapshot.ID),
+		DiskID:    types.StringValue(snapshot.DiskID),
+		Name:      types.StringValue(snapshot.Name),
+		ProjectID: types.StringValue(snapshot.ProjectID),
+		Status:    types.StringValue(snapshot.Status),
+		Size:      types.StringValue(snapshot.Size),
+		CreatedAt: types.StringValue(snapshot.CreatedAt),
+		Labels:    labels.Value(snapshot.Labels),
+	}
+}
+
+func listSnapshots(ctx context.Context, c *client.Client, projectID string) ([]Snapshot, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	var snapshots []Snapshot
+	for {
+		var listResp SnapshotListResponse
+		if err := c.Do(ctx, "GET", "/api/disks/v1/snapshots", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, listResp.Snapshots...)
+		if listResp.NextPageToken == "" {
+			return snapshots, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+func findSnapshotByName(ctx context.Context, c *client.Client, projectID, name string) (*Snapshot, error) {
+	snapshots, err := listSnapshots(ctx, c, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Snapshot
+	for _, snapshot := range snapshots {
+		if snapshot.Name == name {
+			found = append(found, snapshot)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("snapshot %q not found in project %s", name, projectID)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d snapshots named %q in project %s", len(found), name, projectID)
+	}
+}
@@ -1,17 +1,28 @@
 package snapshot
 
 type Snapshot struct {
-	ID        string `json:"id"`
-	DiskID    string `json:"disk_id"`
-	Name      string `json:"name"`
-	Status    string `json:"status"`
-	Size      string `json:"size"`
-	CreatedAt string `json:"created_at"`
-	ProjectID string `json:"project_id"`
+	ID        string            `json:"id"`
+	DiskID    string            `json:"disk_id"`
+	Name      string            `json:"name"`
+	Status    string            `json:"status"`
+	Size      string            `json:"size"`
+	CreatedAt string            `json:"created_at"`
+	ProjectID string            `json:"project_id"`
+	Labels    map[string]string `json:"labels,omitempty"`
 }
 
 type CreateSnapshotRequest struct {
-	DiskID    string `json:"disk_id"`
-	ProjectID string `json:"project_id"`
-	Name      string `json:"name"`
+	DiskID    string            `json:"disk_id"`
+	ProjectID string            `json:"project_id"`
+	Name      string            `json:"name"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+type UpdateSnapshotRequest struct {
+	Labels map[string]string `json:"labels"`
+}
+
+type SnapshotListResponse struct {
+	Snapshots     []Snapshot `json:"snapshots"`
+	NextPageToken string     `json:"next_page_token,omitempty"`
 }
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +19,7 @@ import (
 var _ resource.Resource = &SnapshotResource{}
 var _ resource.ResourceWithConfigure = &SnapshotResource{}
 var _ resource.ResourceWithImportState = &SnapshotResource{}
+var _ resource.ResourceWithModifyPlan = &SnapshotResource{}
 
 func NewSnapshotResource() resource.Resource {
 	return &SnapshotResource{}
@@ -34,6 +37,8 @@ type SnapshotResourceModel struct {
 	Status    types.String `tfsdk:"status"`
 	Size      types.String `tfsdk:"size"`
 	CreatedAt types.String `tfsdk:"created_at"`
+	Labels    types.Map    `tfsdk:"labels"`
+	LabelsAll types.Map    `tfsdk:"labels_all"`
 }
 
 func (r *SnapshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,6 +89,8 @@ func (r *SnapshotResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed:            true,
 				MarkdownDescription: "Creation timestamp",
 			},
+			"labels":     labels.Attribute(),
+			"labels_all": labels.AllAttribute(),
 		},
 	}
 }
@@ -95,6 +102,10 @@ func (r *SnapshotResource) Configure(ctx context.Context, req resource.Configure
 	r.client = req.ProviderData.(*client.Client)
 }
 
+func (r *SnapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+}
+
 func (r *SnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan SnapshotResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -108,6 +119,13 @@ func (r *SnapshotResource) Create(ctx context.Context, req resource.CreateReques
 		Name:      plan.Name.ValueString(),
 	}
 
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Labels = allLabels
+
 	var snapshot Snapshot
 	err := r.client.Do(ctx, "POST", "/api/disks/v1/snapshots", nil, createReq, &snapshot)
 	if err != nil {
@@ -150,16 +168,41 @@ func (r *SnapshotResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.Status = types.StringValue(snapshot.Status)
 	state.Size = types.StringValue(snapshot.Size)
 
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, snapshot.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+	state.LabelsAll = labels.Value(snapshot.Labels)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *SnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan SnapshotResourceModel
+	var plan, state SnapshotResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updateReq := UpdateSnapshotRequest{Labels: allLabels}
+		err := r.client.Do(ctx, "PATCH", "/api/disks/v1/snapshots/"+state.ID.ValueString(), nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating snapshot labels", err.Error())
+			return
+		}
+	}
+
+	plan.Status = state.Status
+	plan.Size = state.Size
+	plan.CreatedAt = state.CreatedAt
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
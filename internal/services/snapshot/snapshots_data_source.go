@@ -0,0 +1,124 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &SnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SnapshotsDataSource{}
+)
+
+func NewSnapshotsDataSource() datasource.DataSource {
+	return &SnapshotsDataSource{}
+}
+
+type SnapshotsDataSource struct {
+	client *client.Client
+}
+
+type SnapshotsDataSourceModel struct {
+	ProjectID types.String              `tfsdk:"project_id"`
+	NameRegex types.String              `tfsdk:"name_regex"`
+	Status    types.String              `tfsdk:"status"`
+	Labels    types.Map                 `tfsdk:"labels"`
+	IDs       types.List                `tfsdk:"ids"`
+	Snapshots []SnapshotDataSourceModel `tfsdk:"snapshots"`
+}
+
+func (d *SnapshotsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshots"
+}
+
+func (d *SnapshotsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := filter.Attributes(true)
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID)",
+		Required:            true,
+	}
+	attrs["ids"] = schema.ListAttribute{
+		MarkdownDescription: "IDs of the matching disk snapshots",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	attrs["snapshots"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Matching disk snapshots",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: snapshotDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists H3 Cloud disk snapshots in a project, optionally filtered by name regex, status and labels",
+		Attributes:          attrs,
+	}
+}
+
+func (d *SnapshotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SnapshotsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, config.Status, config.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listSnapshots(ctx, d.client, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing disk snapshots",
+			fmt.Sprintf("Could not list disk snapshots: %s", err.Error()),
+		)
+		return
+	}
+
+	ids := []string{}
+	config.Snapshots = []SnapshotDataSourceModel{}
+	for _, snapshot := range items {
+		if !f.Match(snapshot.Name, snapshot.Status, snapshot.Labels) {
+			continue
+		}
+
+		item := snapshotDataSourceModel(snapshot)
+		item.ProjectID = config.ProjectID
+
+		ids = append(ids, snapshot.ID)
+		config.Snapshots = append(config.Snapshots, item)
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
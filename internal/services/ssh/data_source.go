@@ -0,0 +1,191 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &SSHKeyDataSource{}
+	_ datasource.DataSourceWithConfigure = &SSHKeyDataSource{}
+)
+
+// NewSSHKeyDataSource создает новый data source SSH ключа
+func NewSSHKeyDataSource() datasource.DataSource {
+	return &SSHKeyDataSource{}
+}
+
+// SSHKeyDataSource - data source для поиска существующего SSH ключа
+type SSHKeyDataSource struct {
+	client *client.Client
+}
+
+// SSHKeyDataSourceModel - модель состояния data source
+type SSHKeyDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	UserID    types.String `tfsdk:"user_id"`
+	Name      types.String `tfsdk:"name"`
+	PublicKey types.String `tfsdk:"public_key"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata возвращает метаданные data source
+func (d *SSHKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+// Schema определяет схему data source
+func (d *SSHKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing H3 Cloud SSH key by ID or by name for a user",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "SSH key ID (UUID, conflicts with name)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User ID (UUID), required when looking up by name",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "SSH key name (conflicts with id)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "SSH public key content",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure инициализирует data source с клиентом
+func (d *SSHKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read ищет SSH ключ по ID или по имени у пользователя
+func (d *SSHKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SSHKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+
+	if hasID == hasName {
+		resp.Diagnostics.AddError(
+			"Invalid SSH key lookup",
+			"Exactly one of id or name must be provided",
+		)
+		return
+	}
+
+	var sshKey SSHKey
+	if hasID {
+		err := d.client.Do(ctx, "GET", "/api/ssh/v1/keys/"+config.ID.ValueString(), nil, nil, &sshKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading SSH key", err.Error())
+			return
+		}
+	} else {
+		if config.UserID.IsNull() || config.UserID.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Missing user_id",
+				"user_id is required when looking up an SSH key by name",
+			)
+			return
+		}
+
+		found, err := findSSHKeyByName(ctx, d.client, config.UserID.ValueString(), config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up SSH key", err.Error())
+			return
+		}
+		sshKey = *found
+	}
+
+	state := SSHKeyDataSourceModel{
+		ID:        types.StringValue(sshKey.ID),
+		UserID:    types.StringValue(sshKey.UserID),
+		Name:      types.StringValue(sshKey.Name),
+		PublicKey: types.StringValue(sshKey.PublicKey),
+		CreatedAt: types.StringValue(sshKey.CreatedAt),
+		UpdatedAt: types.StringValue(sshKey.UpdatedAt),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// listSSHKeys возвращает все SSH ключи пользователя
+func listSSHKeys(ctx context.Context, c *client.Client, userID string) ([]SSHKey, error) {
+	queryParams := map[string]string{
+		"user_id": userID,
+	}
+
+	var listResp SSHKeyListResponse
+	if err := c.Do(ctx, "GET", "/api/ssh/v1/keys", queryParams, nil, &listResp); err != nil {
+		return nil, err
+	}
+
+	return listResp.Keys, nil
+}
+
+// findSSHKeyByName ищет SSH ключ по имени у пользователя (имя должно быть уникальным)
+func findSSHKeyByName(ctx context.Context, c *client.Client, userID, name string) (*SSHKey, error) {
+	keys, err := listSSHKeys(ctx, c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []SSHKey
+	for _, key := range keys {
+		if key.Name == name {
+			found = append(found, key)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("SSH key %q not found for user %s", name, userID)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d SSH keys named %q for user %s", len(found), name, userID)
+	}
+}
@@ -22,3 +22,8 @@ type SSHKey struct {
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 }
+
+// SSHKeyListResponse - list response from API
+type SSHKeyListResponse struct {
+	Keys []SSHKey `json:"keys"`
+}
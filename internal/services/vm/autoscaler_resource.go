@@ -0,0 +1,430 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"h3terraform/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &VMAutoscalerResource{}
+	_ resource.ResourceWithConfigure   = &VMAutoscalerResource{}
+	_ resource.ResourceWithImportState = &VMAutoscalerResource{}
+	_ resource.ResourceWithModifyPlan  = &VMAutoscalerResource{}
+)
+
+// NewVMAutoscalerResource создает новый ресурс автоскейлера группы VM
+func NewVMAutoscalerResource() resource.Resource {
+	return &VMAutoscalerResource{}
+}
+
+// VMAutoscalerResource - автоскейлер группы VM по загрузке CPU и расписанию
+type VMAutoscalerResource struct {
+	client *client.Client
+}
+
+// VMAutoscalerResourceModel - модель состояния ресурса
+type VMAutoscalerResourceModel struct {
+	ID                   types.String              `tfsdk:"id"`
+	ProjectID            types.String              `tfsdk:"project_id"`
+	GroupID              types.String              `tfsdk:"group_id"`
+	MinSize              types.Int64               `tfsdk:"min_size"`
+	MaxSize              types.Int64               `tfsdk:"max_size"`
+	TargetCPUUtilization types.Int64               `tfsdk:"target_cpu_utilization"`
+	CooldownSeconds      types.Int64               `tfsdk:"cooldown_seconds"`
+	Schedules            []AutoscalerScheduleModel `tfsdk:"schedule"`
+	CurrentSize          types.Int64               `tfsdk:"current_size"`
+	Status               types.String              `tfsdk:"status"`
+	CreatedAt            types.String              `tfsdk:"created_at"`
+}
+
+// AutoscalerScheduleModel - модель блока schedule
+type AutoscalerScheduleModel struct {
+	Name     types.String `tfsdk:"name"`
+	Cron     types.String `tfsdk:"cron"`
+	TimeZone types.String `tfsdk:"time_zone"`
+	MinSize  types.Int64  `tfsdk:"min_size"`
+	MaxSize  types.Int64  `tfsdk:"max_size"`
+}
+
+// Metadata возвращает метаданные ресурса
+func (r *VMAutoscalerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_autoscaler"
+}
+
+// Schema определяет схему ресурса
+func (r *VMAutoscalerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Autoscaling policy for an `h3_vm_group`: keeps average CPU utilization near a target within size bounds, optionally overridden by schedules. While attached, the group's `size` is ignored",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Autoscaler ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID (UUID)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the `h3_vm_group` to scale. A group can have only one autoscaler",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"min_size": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of VMs",
+				Required:            true,
+			},
+			"max_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of VMs",
+				Required:            true,
+			},
+			"target_cpu_utilization": schema.Int64Attribute{
+				MarkdownDescription: "Target average CPU utilization across the group, in percent (1-100)",
+				Required:            true,
+			},
+			"cooldown_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Minimum time between two scaling actions, in seconds (default: 300)",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+			},
+			"current_size": schema.Int64Attribute{
+				MarkdownDescription: "Group size currently chosen by the autoscaler",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Autoscaler status",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"schedule": schema.ListNestedBlock{
+				MarkdownDescription: "Scheduled change of the size bounds, e.g. scale down to 2 VMs at night. The bounds stay in effect until the next schedule fires",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Schedule name (unique within the autoscaler)",
+							Required:            true,
+						},
+						"cron": schema.StringAttribute{
+							MarkdownDescription: "Cron expression with 5 fields, e.g. `0 22 * * *`",
+							Required:            true,
+						},
+						"time_zone": schema.StringAttribute{
+							MarkdownDescription: "IANA time zone of the cron expression, e.g. `Europe/Moscow` (default: UTC)",
+							Optional:            true,
+						},
+						"min_size": schema.Int64Attribute{
+							MarkdownDescription: "Minimum number of VMs once the schedule fires",
+							Required:            true,
+						},
+						"max_size": schema.Int64Attribute{
+							MarkdownDescription: "Maximum number of VMs once the schedule fires",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure инициализирует ресурс с клиентом
+func (r *VMAutoscalerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan проверяет границы размера, целевую загрузку CPU и расписания
+func (r *VMAutoscalerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan VMAutoscalerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := checkSizeBounds(plan.MinSize, plan.MaxSize); err != nil {
+		resp.Diagnostics.AddError("Invalid autoscaler size bounds", err.Error())
+	}
+
+	if !plan.TargetCPUUtilization.IsUnknown() {
+		if v := plan.TargetCPUUtilization.ValueInt64(); v < 1 || v > 100 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("target_cpu_utilization"),
+				"Invalid target_cpu_utilization",
+				fmt.Sprintf("target_cpu_utilization must be between 1 and 100, got %d", v),
+			)
+		}
+	}
+
+	if !plan.CooldownSeconds.IsUnknown() && plan.CooldownSeconds.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cooldown_seconds"),
+			"Invalid cooldown_seconds",
+			"cooldown_seconds must not be negative",
+		)
+	}
+
+	names := make(map[string]bool, len(plan.Schedules))
+	for i, s := range plan.Schedules {
+		schedulePath := path.Root("schedule").AtListIndex(i)
+
+		if !s.Name.IsUnknown() {
+			if names[s.Name.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					schedulePath.AtName("name"),
+					"Duplicate schedule name",
+					fmt.Sprintf("Schedule name %q is used more than once", s.Name.ValueString()),
+				)
+			}
+			names[s.Name.ValueString()] = true
+		}
+
+		if !s.Cron.IsUnknown() && len(strings.Fields(s.Cron.ValueString())) != 5 {
+			resp.Diagnostics.AddAttributeError(
+				schedulePath.AtName("cron"),
+				"Invalid cron expression",
+				fmt.Sprintf("Expected 5 fields (minute hour day month weekday), got %q", s.Cron.ValueString()),
+			)
+		}
+
+		if !s.TimeZone.IsNull() && !s.TimeZone.IsUnknown() {
+			if _, err := time.LoadLocation(s.TimeZone.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					schedulePath.AtName("time_zone"),
+					"Invalid time zone",
+					err.Error(),
+				)
+			}
+		}
+
+		if err := checkSizeBounds(s.MinSize, s.MaxSize); err != nil {
+			resp.Diagnostics.AddAttributeError(schedulePath, "Invalid schedule size bounds", err.Error())
+		}
+	}
+}
+
+// Create подключает автоскейлер к группе VM
+func (r *VMAutoscalerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VMAutoscalerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := CreateVMAutoscalerRequest{
+		ProjectID:            plan.ProjectID.ValueString(),
+		GroupID:              plan.GroupID.ValueString(),
+		MinSize:              int(plan.MinSize.ValueInt64()),
+		MaxSize:              int(plan.MaxSize.ValueInt64()),
+		TargetCPUUtilization: int(plan.TargetCPUUtilization.ValueInt64()),
+		CooldownSeconds:      int(plan.CooldownSeconds.ValueInt64()),
+		Schedules:            autoscalerSchedules(plan.Schedules),
+	}
+
+	var autoscaler VMAutoscaler
+	err := r.client.Do(ctx, "POST", "/api/vms/v1/autoscalers", nil, createReq, &autoscaler)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating VM autoscaler",
+			"Could not create VM autoscaler: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(autoscaler.ID)
+	setAutoscalerFromAPI(&plan, autoscaler)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read читает текущее состояние автоскейлера
+func (r *VMAutoscalerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VMAutoscalerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var autoscaler VMAutoscaler
+	err := r.client.Do(ctx, "GET", "/api/vms/v1/autoscalers/"+state.ID.ValueString(), nil, nil, &autoscaler)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading VM autoscaler", err.Error())
+		return
+	}
+
+	state.ProjectID = types.StringValue(autoscaler.ProjectID)
+	state.GroupID = types.StringValue(autoscaler.GroupID)
+	state.MinSize = types.Int64Value(int64(autoscaler.MinSize))
+	state.MaxSize = types.Int64Value(int64(autoscaler.MaxSize))
+	state.TargetCPUUtilization = types.Int64Value(int64(autoscaler.TargetCPUUtilization))
+	state.CooldownSeconds = types.Int64Value(int64(autoscaler.CooldownSeconds))
+	state.Schedules = autoscalerScheduleModels(autoscaler.Schedules, state.Schedules)
+	setAutoscalerFromAPI(&state, autoscaler)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update обновляет политику автоскейлера
+func (r *VMAutoscalerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VMAutoscalerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := UpdateVMAutoscalerRequest{
+		MinSize:              int(plan.MinSize.ValueInt64()),
+		MaxSize:              int(plan.MaxSize.ValueInt64()),
+		TargetCPUUtilization: int(plan.TargetCPUUtilization.ValueInt64()),
+		CooldownSeconds:      int(plan.CooldownSeconds.ValueInt64()),
+		Schedules:            autoscalerSchedules(plan.Schedules),
+	}
+
+	var autoscaler VMAutoscaler
+	err := r.client.Do(ctx, "PATCH", "/api/vms/v1/autoscalers/"+state.ID.ValueString(), nil, updateReq, &autoscaler)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating VM autoscaler",
+			"Could not update VM autoscaler: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt = state.CreatedAt
+	setAutoscalerFromAPI(&plan, autoscaler)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete отключает автоскейлер (группа сохраняет текущий размер)
+func (r *VMAutoscalerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VMAutoscalerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Do(ctx, "DELETE", "/api/vms/v1/autoscalers/"+state.ID.ValueString(), nil, nil, nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting VM autoscaler", err.Error())
+	}
+}
+
+// ImportState импортирует автоскейлер по ID
+func (r *VMAutoscalerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkSizeBounds проверяет 0 <= min_size <= max_size (неизвестные значения пропускает)
+func checkSizeBounds(minSize, maxSize types.Int64) error {
+	if minSize.IsUnknown() || maxSize.IsUnknown() {
+		return nil
+	}
+	if minSize.ValueInt64() < 0 {
+		return fmt.Errorf("min_size must not be negative, got %d", minSize.ValueInt64())
+	}
+	if minSize.ValueInt64() > maxSize.ValueInt64() {
+		return fmt.Errorf("min_size (%d) must not exceed max_size (%d)", minSize.ValueInt64(), maxSize.ValueInt64())
+	}
+	return nil
+}
+
+// autoscalerSchedules формирует расписания для запроса к API
+func autoscalerSchedules(schedules []AutoscalerScheduleModel) []AutoscalerSchedule {
+	specs := make([]AutoscalerSchedule, 0, len(schedules))
+	for _, s := range schedules {
+		specs = append(specs, AutoscalerSchedule{
+			Name:     s.Name.ValueString(),
+			Cron:     s.Cron.ValueString(),
+			TimeZone: s.TimeZone.ValueString(),
+			MinSize:  int(s.MinSize.ValueInt64()),
+			MaxSize:  int(s.MaxSize.ValueInt64()),
+		})
+	}
+	return specs
+}
+
+// autoscalerScheduleModels конвертирует расписания из ответа API в блоки schedule
+// (time_zone остается null, если он не был задан и backend вернул значение по умолчанию)
+func autoscalerScheduleModels(schedules []AutoscalerSchedule, current []AutoscalerScheduleModel) []AutoscalerScheduleModel {
+	configuredTZ := make(map[string]bool, len(current))
+	for _, s := range current {
+		configuredTZ[s.Name.ValueString()] = !s.TimeZone.IsNull()
+	}
+
+	var models []AutoscalerScheduleModel
+	for _, s := range schedules {
+		timeZone := types.StringValue(s.TimeZone)
+		if s.TimeZone == "" || (s.TimeZone == "UTC" && !configuredTZ[s.Name]) {
+			timeZone = types.StringNull()
+		}
+		models = append(models, AutoscalerScheduleModel{
+			Name:     types.StringValue(s.Name),
+			Cron:     types.StringValue(s.Cron),
+			TimeZone: timeZone,
+			MinSize:  types.Int64Value(int64(s.MinSize)),
+			MaxSize:  types.Int64Value(int64(s.MaxSize)),
+		})
+	}
+	return models
+}
+
+// setAutoscalerFromAPI заполняет computed атрибуты автоскейлера из ответа API
+func setAutoscalerFromAPI(model *VMAutoscalerResourceModel, autoscaler VMAutoscaler) {
+	model.CurrentSize = types.Int64Value(int64(autoscaler.CurrentSize))
+	model.Status = types.StringValue(autoscaler.Status)
+	model.CreatedAt = types.StringValue(autoscaler.CreatedAt)
+}
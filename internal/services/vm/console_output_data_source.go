@@ -0,0 +1,146 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"h3terraform/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// consoleCaptureLines - сколько строк консоли добавлять в диагностику при capture_console_on_failure
+const consoleCaptureLines = 50
+
+var (
+	_ datasource.DataSource              = &ConsoleOutputDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConsoleOutputDataSource{}
+)
+
+// NewConsoleOutputDataSource создает новый data source вывода serial console VM
+func NewConsoleOutputDataSource() datasource.DataSource {
+	return &ConsoleOutputDataSource{}
+}
+
+// ConsoleOutputDataSource - data source для чтения serial console VM
+type ConsoleOutputDataSource struct {
+	client *client.Client
+}
+
+// ConsoleOutputDataSourceModel - модель состояния data source
+type ConsoleOutputDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	VMID      types.String `tfsdk:"vm_id"`
+	TailLines types.Int64  `tfsdk:"tail_lines"`
+	Output    types.String `tfsdk:"output"`
+}
+
+// Metadata возвращает метаданные data source
+func (d *ConsoleOutputDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_console_output"
+}
+
+// Schema определяет схему data source
+func (d *ConsoleOutputDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the serial console log of a VM, useful for debugging boot failures",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Same as vm_id",
+				Computed:            true,
+			},
+			"vm_id": schema.StringAttribute{
+				MarkdownDescription: "VM ID",
+				Required:            true,
+			},
+			"tail_lines": schema.Int64Attribute{
+				MarkdownDescription: "Only return the last N lines (default: the whole log kept by the backend)",
+				Optional:            true,
+			},
+			"output": schema.StringAttribute{
+				MarkdownDescription: "Serial console output",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure инициализирует data source с клиентом
+func (d *ConsoleOutputDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read читает вывод serial console VM
+func (d *ConsoleOutputDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ConsoleOutputDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TailLines.IsNull() && config.TailLines.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tail_lines"),
+			"Invalid tail_lines",
+			fmt.Sprintf("tail_lines must be positive, got: %d", config.TailLines.ValueInt64()),
+		)
+		return
+	}
+
+	output, err := getConsoleOutput(ctx, d.client, config.VMID.ValueString(), int(config.TailLines.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading VM console output", err.Error())
+		return
+	}
+
+	config.ID = config.VMID
+	config.Output = types.StringValue(output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// consoleOnFailure возвращает последние строки serial console для диагностики неудачного создания VM
+// (ошибка чтения консоли не должна скрывать исходную ошибку)
+func consoleOnFailure(ctx context.Context, c *client.Client, vmID string) string {
+	output, err := getConsoleOutput(ctx, c, vmID, consoleCaptureLines)
+	if err != nil {
+		return "\n\nConsole output unavailable: " + err.Error()
+	}
+	if output == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n\nLast %d lines of console output:\n%s", consoleCaptureLines, output)
+}
+
+// getConsoleOutput возвращает вывод serial console VM (tailLines <= 0 - весь лог)
+func getConsoleOutput(ctx context.Context, c *client.Client, vmID string, tailLines int) (string, error) {
+	var queryParams map[string]string
+	if tailLines > 0 {
+		queryParams = map[string]string{
+			"tail_lines": strconv.Itoa(tailLines),
+		}
+	}
+
+	var console ConsoleOutput
+	if err := c.Do(ctx, "GET", "/api/vms/v1/"+vmID+"/console", queryParams, nil, &console); err != nil {
+		return "", fmt.Errorf("could not read console output of VM %s: %w", vmID, err)
+	}
+	return console.Output, nil
+}
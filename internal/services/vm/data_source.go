@@ -0,0 +1,296 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &VMDataSource{}
+	_ datasource.DataSourceWithConfigure = &VMDataSource{}
+)
+
+// NewVMDataSource создает новый data source VM
+func NewVMDataSource() datasource.DataSource {
+	return &VMDataSource{}
+}
+
+// VMDataSource - data source для поиска существующей VM
+type VMDataSource struct {
+	client *client.Client
+}
+
+// VMDataSourceModel - модель состояния data source
+type VMDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	Name          types.String `tfsdk:"name"`
+	CPU           types.Int64  `tfsdk:"cpu"`
+	Memory        types.String `tfsdk:"memory"`
+	DiskSize      types.String `tfsdk:"disk_size"`
+	Image         types.String `tfsdk:"image"`
+	SubnetName    types.String `tfsdk:"subnet_name"`
+	WhiteIP       types.Bool   `tfsdk:"white_ip"`
+	SubnetID      types.String `tfsdk:"subnet_id"`
+	PrivateIP     types.String `tfsdk:"private_ip"`
+	PublicIP      types.String `tfsdk:"public_ip"`
+	IPv6Addresses types.List   `tfsdk:"ipv6_addresses"`
+	MACAddress    types.String `tfsdk:"mac_address"`
+	Status        types.String `tfsdk:"status"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	BootDiskID    types.String `tfsdk:"boot_disk_id"`
+	Labels        types.Map    `tfsdk:"labels"`
+}
+
+// Metadata возвращает метаданные data source
+func (d *VMDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm"
+}
+
+// Schema определяет схему data source
+func (d *VMDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := vmDataSourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "VM ID (conflicts with name)",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID), required when looking up by name",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "VM name (conflicts with id)",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing H3 Cloud virtual machine by ID or by name within a project",
+		Attributes:          attrs,
+	}
+}
+
+// vmDataSourceAttributes - read-only атрибуты, общие для singular и plural data source
+func vmDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "VM ID",
+			Computed:            true,
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "Project ID (UUID)",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "VM name",
+			Computed:            true,
+		},
+		"cpu": schema.Int64Attribute{
+			MarkdownDescription: "Number of CPU cores",
+			Computed:            true,
+		},
+		"memory": schema.StringAttribute{
+			MarkdownDescription: "Memory size",
+			Computed:            true,
+		},
+		"disk_size": schema.StringAttribute{
+			MarkdownDescription: "Disk size",
+			Computed:            true,
+		},
+		"image": schema.StringAttribute{
+			MarkdownDescription: "OS image",
+			Computed:            true,
+		},
+		"subnet_name": schema.StringAttribute{
+			MarkdownDescription: "Subnet name",
+			Computed:            true,
+		},
+		"white_ip": schema.BoolAttribute{
+			MarkdownDescription: "Whether a public IP is enabled",
+			Computed:            true,
+		},
+		"subnet_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the subnet the primary interface is attached to",
+			Computed:            true,
+		},
+		"private_ip": schema.StringAttribute{
+			MarkdownDescription: "Private IPv4 address of the primary interface",
+			Computed:            true,
+		},
+		"public_ip": schema.StringAttribute{
+			MarkdownDescription: "Public IP address, if any",
+			Computed:            true,
+		},
+		"ipv6_addresses": schema.ListAttribute{
+			MarkdownDescription: "IPv6 addresses of the primary interface",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"mac_address": schema.StringAttribute{
+			MarkdownDescription: "MAC address of the primary interface",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "VM status (PENDING, RUNNING, etc.)",
+			Computed:            true,
+		},
+		"endpoint": schema.StringAttribute{
+			MarkdownDescription: "VM endpoint/IP address",
+			Computed:            true,
+		},
+		"boot_disk_id": schema.StringAttribute{
+			MarkdownDescription: "Boot disk ID",
+			Computed:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels attached to the VM",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+// Configure инициализирует data source с клиентом
+func (d *VMDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read ищет VM по ID или по имени в проекте
+func (d *VMDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config VMDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+
+	if hasID == hasName {
+		resp.Diagnostics.AddError(
+			"Invalid VM lookup",
+			"Exactly one of id or name must be provided",
+		)
+		return
+	}
+
+	var vm VM
+	if hasID {
+		err := d.client.Do(ctx, "GET", "/api/vms/v1/"+config.ID.ValueString(), nil, nil, &vm)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading VM", err.Error())
+			return
+		}
+	} else {
+		if config.ProjectID.IsNull() || config.ProjectID.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Missing project_id",
+				"project_id is required when looking up a VM by name",
+			)
+			return
+		}
+
+		found, err := findVMByName(ctx, d.client, config.ProjectID.ValueString(), config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up VM", err.Error())
+			return
+		}
+		vm = *found
+	}
+
+	state := vmDataSourceModel(vm)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// vmDataSourceModel конвертирует ответ API в модель data source
+func vmDataSourceModel(vm VM) VMDataSourceModel {
+	return VMDataSourceModel{
+		ID:            types.StringValue(vm.ID),
+		ProjectID:     types.StringValue(vm.ProjectID),
+		Name:          types.StringValue(vm.Name),
+		CPU:           types.Int64Value(int64(vm.CPU)),
+		Memory:        types.StringValue(vm.Memory),
+		DiskSize:      types.StringValue(vm.DiskSize),
+		Image:         types.StringValue(vm.Image),
+		SubnetName:    types.StringValue(vm.SubnetName),
+		WhiteIP:       types.BoolValue(vm.WhiteIP),
+		SubnetID:      types.StringValue(vm.SubnetID),
+		PrivateIP:     types.StringValue(vm.PrivateIP),
+		PublicIP:      types.StringValue(vm.PublicIP),
+		IPv6Addresses: stringListValue(vm.IPv6Addresses),
+		MACAddress:    types.StringValue(vm.MACAddress),
+		Status:        types.StringValue(vm.Status),
+		Endpoint:      types.StringValue(vm.Endpoint),
+		BootDiskID:    types.StringValue(vm.BootDiskID),
+		Labels:        labels.Value(vm.Labels),
+	}
+}
+
+// listVMs возвращает все VM проекта
+func listVMs(ctx context.Context, c *client.Client, projectID string) ([]VM, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	// Backend отдает список постранично, идем по next_page_token до конца
+	var vms []VM
+	for {
+		var listResp VMListResponse
+		if err := c.Do(ctx, "GET", "/api/vms/v1", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		vms = append(vms, listResp.VMs...)
+		if listResp.NextPageToken == "" {
+			return vms, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+// findVMByName ищет VM по имени в проекте (имя должно быть уникальным)
+func findVMByName(ctx context.Context, c *client.Client, projectID, name string) (*VM, error) {
+	vms, err := listVMs(ctx, c, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []VM
+	for _, vm := range vms {
+		if vm.Name == name {
+			found = append(found, vm)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("VM %q not found in project %s", name, projectID)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d VMs named %q in project %s", len(found), name, projectID)
+	}
+}
@@ -0,0 +1,498 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"h3terraform/internal/pkg/quantity"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// BootDiskModel - модель блока boot_disk
+type BootDiskModel struct {
+	Size             types.String `tfsdk:"size"`
+	StorageClass     types.String `tfsdk:"storage_class"`
+	Image            types.String `tfsdk:"image"`
+	SourceSnapshotID types.String `tfsdk:"source_snapshot_id"`
+	DiskID           types.String `tfsdk:"disk_id"`
+}
+
+// DataDiskModel - модель блока data_disk
+type DataDiskModel struct {
+	Name         types.String `tfsdk:"name"`
+	Size         types.String `tfsdk:"size"`
+	StorageClass types.String `tfsdk:"storage_class"`
+	DiskID       types.String `tfsdk:"disk_id"`
+}
+
+// bootDiskAttrTypes - типы атрибутов блока boot_disk
+var bootDiskAttrTypes = map[string]attr.Type{
+	"size":               types.StringType,
+	"storage_class":      types.StringType,
+	"image":              types.StringType,
+	"source_snapshot_id": types.StringType,
+	"disk_id":            types.StringType,
+}
+
+// dataDiskAttrTypes - типы атрибутов блока data_disk
+var dataDiskAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"size":          types.StringType,
+	"storage_class": types.StringType,
+	"disk_id":       types.StringType,
+}
+
+// bootDiskFromObject читает блок boot_disk (nil, если блок не задан или еще не известен)
+func bootDiskFromObject(ctx context.Context, obj types.Object) (*BootDiskModel, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+	var bootDisk BootDiskModel
+	diags := obj.As(ctx, &bootDisk, basetypes.ObjectAsOptions{})
+	return &bootDisk, diags
+}
+
+// bootDiskObject конвертирует модель boot_disk в types.Object (nil дает null)
+func bootDiskObject(ctx context.Context, bootDisk *BootDiskModel) (types.Object, diag.Diagnostics) {
+	if bootDisk == nil {
+		return types.ObjectNull(bootDiskAttrTypes), nil
+	}
+	return types.ObjectValueFrom(ctx, bootDiskAttrTypes, bootDisk)
+}
+
+// dataDisksFromList читает блоки data_disk (nil, если список null или еще не известен,
+// например при dynamic блоке с неизвестным for_each)
+func dataDisksFromList(ctx context.Context, list types.List) ([]DataDiskModel, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	dataDisks := make([]DataDiskModel, 0, len(list.Elements()))
+	diags := list.ElementsAs(ctx, &dataDisks, false)
+	return dataDisks, diags
+}
+
+// dataDisksList конвертирует модели data_disk в types.List
+func dataDisksList(ctx context.Context, dataDisks []DataDiskModel) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dataDiskAttrTypes}, dataDisks)
+}
+
+// diskBlocks - схема блоков boot_disk и data_disk
+func diskBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"boot_disk": schema.SingleNestedBlock{
+			MarkdownDescription: "Boot disk settings (alternative to disk_size/image/source_snapshot_id). The size can be grown in place",
+			Attributes: map[string]schema.Attribute{
+				"size": schema.StringAttribute{
+					MarkdownDescription: "Boot disk size (e.g., 25Gi); can only grow",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"storage_class": schema.StringAttribute{
+					MarkdownDescription: "Storage class (e.g., 'replicated')",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"image": schema.StringAttribute{
+					MarkdownDescription: "OS image name or ID. Changing it replaces the VM, or rebuilds it in place with `rebuild_on_image_change`",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+						imageReplaceIf(),
+					},
+				},
+				"source_snapshot_id": schema.StringAttribute{
+					MarkdownDescription: "Create the boot disk from this snapshot",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"disk_id": schema.StringAttribute{
+					MarkdownDescription: "Boot disk ID",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+		"data_disk": schema.ListNestedBlock{
+			MarkdownDescription: "Additional disks created and attached together with the VM. Disks are matched by name: adding or removing a block creates or deletes a disk, a larger size grows it in place",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Disk name (unique within the VM)",
+						Required:            true,
+					},
+					"size": schema.StringAttribute{
+						MarkdownDescription: "Disk size (e.g., 100Gi); can only grow",
+						Required:            true,
+					},
+					"storage_class": schema.StringAttribute{
+						MarkdownDescription: "Storage class (e.g., 'replicated')",
+						Required:            true,
+					},
+					"disk_id": schema.StringAttribute{
+						MarkdownDescription: "Disk ID",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// modifyPlanDisks проверяет блоки дисков и переносит disk_id data дисков из state по имени
+// (UseStateForUnknown сопоставляет элементы списка по индексу, что ломается при удалении из середины).
+// Блоки, которые еще не известны (dynamic с неизвестным for_each), не проверяются - план пересчитается при apply
+func (r *VMResource) modifyPlanDisks(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state VMResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBootDisk, diags := bootDiskFromObject(ctx, plan.BootDisk)
+	resp.Diagnostics.Append(diags...)
+	stateBootDisk, diags := bootDiskFromObject(ctx, state.BootDisk)
+	resp.Diagnostics.Append(diags...)
+	planDataDisks, diags := dataDisksFromList(ctx, plan.DataDisks)
+	resp.Diagnostics.Append(diags...)
+	stateDataDisks, diags := dataDisksFromList(ctx, state.DataDisks)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.BootDisk.IsNull() {
+		// disk_size и image Computed, поэтому проверяем по конфигурации
+		var configDiskSize, configImage types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disk_size"), &configDiskSize)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("image"), &configImage)...)
+		if !configDiskSize.IsNull() || !configImage.IsNull() || !plan.SourceSnapshotID.IsNull() {
+			resp.Diagnostics.AddError(
+				"Conflicting boot disk settings",
+				"boot_disk is mutually exclusive with disk_size, image and source_snapshot_id",
+			)
+			return
+		}
+	}
+
+	// Уменьшать диски нельзя
+	if !req.State.Raw.IsNull() {
+		if err := quantity.CheckGrowOnly("disk_size", state.DiskSize, plan.DiskSize); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("disk_size"), "Invalid disk size", err.Error())
+		}
+		if planBootDisk != nil && stateBootDisk != nil {
+			if err := quantity.CheckGrowOnly("boot_disk.size", stateBootDisk.Size, planBootDisk.Size); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("boot_disk").AtName("size"), "Invalid disk size", err.Error())
+			}
+		}
+	}
+
+	if planDataDisks == nil {
+		return
+	}
+
+	existing := make(map[string]DataDiskModel, len(stateDataDisks))
+	for _, d := range stateDataDisks {
+		existing[d.Name.ValueString()] = d
+	}
+
+	seen := make(map[string]bool, len(planDataDisks))
+	for i, d := range planDataDisks {
+		name := d.Name.ValueString()
+		if d.Name.IsUnknown() {
+			planDataDisks[i].DiskID = types.StringUnknown()
+			continue
+		}
+		if seen[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("data_disk").AtListIndex(i).AtName("name"),
+				"Duplicate data disk name",
+				fmt.Sprintf("data disk name %q is used more than once", name),
+			)
+			return
+		}
+		seen[name] = true
+
+		old, ok := existing[name]
+		if !ok {
+			planDataDisks[i].DiskID = types.StringUnknown()
+			continue
+		}
+		planDataDisks[i].DiskID = old.DiskID
+
+		if !d.StorageClass.IsUnknown() && !d.StorageClass.Equal(old.StorageClass) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("data_disk").AtListIndex(i).AtName("storage_class"),
+				"Cannot change data disk storage class",
+				fmt.Sprintf("storage_class of data disk %q cannot be changed in place; rename the disk to create a new one (the old disk and its data will be deleted)", name),
+			)
+		}
+		if err := quantity.CheckGrowOnly(fmt.Sprintf("size of data disk %q", name), old.Size, d.Size); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("data_disk").AtListIndex(i).AtName("size"), "Invalid disk size", err.Error())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("data_disk"), planDataDisks)...)
+}
+
+// applyDataDiskChanges создает, увеличивает и удаляет data диски VM по разнице plan/state
+func (r *VMResource) applyDataDiskChanges(ctx context.Context, vmID string, plan, state []DataDiskModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planned := make(map[string]DataDiskModel, len(plan))
+	for _, d := range plan {
+		planned[d.Name.ValueString()] = d
+	}
+
+	// Сначала удаляем, чтобы освободить имена и квоту
+	for _, d := range state {
+		if _, ok := planned[d.Name.ValueString()]; ok {
+			continue
+		}
+		err := r.client.Do(ctx, "DELETE", "/api/vms/v1/"+vmID+"/disks/"+d.DiskID.ValueString(), nil, nil, nil)
+		if err != nil {
+			diags.AddError("Error removing data disk", fmt.Sprintf("Could not remove data disk %q: %s", d.Name.ValueString(), err.Error()))
+			return diags
+		}
+	}
+
+	existing := make(map[string]DataDiskModel, len(state))
+	for _, d := range state {
+		existing[d.Name.ValueString()] = d
+	}
+
+	for _, d := range plan {
+		old, ok := existing[d.Name.ValueString()]
+		if !ok {
+			spec := DataDiskSpec{
+				Name:         d.Name.ValueString(),
+				Size:         d.Size.ValueString(),
+				StorageClass: d.StorageClass.ValueString(),
+			}
+			if err := r.client.Do(ctx, "POST", "/api/vms/v1/"+vmID+"/disks", nil, spec, nil); err != nil {
+				diags.AddError("Error adding data disk", fmt.Sprintf("Could not add data disk %q: %s", spec.Name, err.Error()))
+				return diags
+			}
+			continue
+		}
+
+		if !d.Size.Equal(old.Size) {
+			updateReq := UpdateVMDiskRequest{Size: d.Size.ValueString()}
+			if err := r.client.Do(ctx, "PATCH", "/api/vms/v1/"+vmID+"/disks/"+old.DiskID.ValueString(), nil, updateReq, nil); err != nil {
+				diags.AddError("Error resizing data disk", fmt.Sprintf("Could not resize data disk %q: %s", d.Name.ValueString(), err.Error()))
+				return diags
+			}
+		}
+	}
+
+	// Подключение и удаление дисков асинхронные - ждем, чтобы финальный GET совпал с планом
+	if err := r.waitForVMDisks(ctx, vmID, plan, 10*time.Minute); err != nil {
+		diags.AddError("Error waiting for data disks", "Data disk changes were requested but not applied: "+err.Error())
+	}
+
+	return diags
+}
+
+// waitForVMDisks ждет, пока набор data дисков VM совпадет с планом: все диски подключены
+// с нужным размером, а удаленные отключены
+func (r *VMResource) waitForVMDisks(ctx context.Context, vmID string, dataDisks []DataDiskModel, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for data disks of VM %s to be attached", vmID)
+		case <-ticker.C:
+			var vm VM
+			if err := r.client.Do(ctx, "GET", "/api/vms/v1/"+vmID, nil, nil, &vm); err != nil {
+				return err
+			}
+			if vm.Status == "ERROR" {
+				return r.vmFailure(ctx, vm)
+			}
+
+			pending := dataDisksPending(dataDisks, vm.Disks)
+			log.Printf("[DEBUG] waitForVMDisks: VM %s pending data disks: %v", vmID, pending)
+			if len(pending) == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// dataDisksPending возвращает имена data дисков, состояние которых в API еще не совпадает с планом
+func dataDisksPending(dataDisks []DataDiskModel, apiDisks []VMDisk) []string {
+	attached := make(map[string]VMDisk, len(apiDisks))
+	for _, d := range apiDisks {
+		if !d.Boot {
+			attached[d.Name] = d
+		}
+	}
+
+	var pending []string
+	planned := make(map[string]bool, len(dataDisks))
+	for _, d := range dataDisks {
+		name := d.Name.ValueString()
+		planned[name] = true
+		api, ok := attached[name]
+		if !ok || !quantity.Equal(api.Size, d.Size.ValueString()) {
+			pending = append(pending, name)
+		}
+	}
+	for name := range attached {
+		if !planned[name] {
+			pending = append(pending, name)
+		}
+	}
+	return pending
+}
+
+// dataDisksChanged проверяет, отличается ли набор data дисков в plan и state
+func dataDisksChanged(plan, state []DataDiskModel) bool {
+	if len(plan) != len(state) {
+		return true
+	}
+	existing := make(map[string]DataDiskModel, len(state))
+	for _, d := range state {
+		existing[d.Name.ValueString()] = d
+	}
+	for _, d := range plan {
+		old, ok := existing[d.Name.ValueString()]
+		if !ok || !d.Size.Equal(old.Size) || !d.StorageClass.Equal(old.StorageClass) {
+			return true
+		}
+	}
+	return false
+}
+
+// bootDiskSpec формирует параметры boot диска для запроса создания
+func bootDiskSpec(bootDisk *BootDiskModel) *BootDiskSpec {
+	if bootDisk == nil {
+		return nil
+	}
+	spec := &BootDiskSpec{}
+	if !bootDisk.Size.IsUnknown() {
+		spec.Size = bootDisk.Size.ValueString()
+	}
+	if !bootDisk.StorageClass.IsUnknown() {
+		spec.StorageClass = bootDisk.StorageClass.ValueString()
+	}
+	if !bootDisk.Image.IsUnknown() {
+		spec.Image = bootDisk.Image.ValueString()
+	}
+	spec.SourceSnapshotID = bootDisk.SourceSnapshotID.ValueString()
+	return spec
+}
+
+// dataDiskSpecs формирует параметры data дисков для запроса создания
+func dataDiskSpecs(dataDisks []DataDiskModel) []DataDiskSpec {
+	specs := make([]DataDiskSpec, 0, len(dataDisks))
+	for _, d := range dataDisks {
+		specs = append(specs, DataDiskSpec{
+			Name:         d.Name.ValueString(),
+			Size:         d.Size.ValueString(),
+			StorageClass: d.StorageClass.ValueString(),
+		})
+	}
+	return specs
+}
+
+// setDisksFromAPI заполняет boot_disk и data_disk из ответа API
+// (data диски, которых больше нет в API, удаляются из state - план создаст их заново)
+func setDisksFromAPI(ctx context.Context, model *VMResourceModel, vm VM) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	byName := make(map[string]VMDisk, len(vm.Disks))
+	var boot *VMDisk
+	for i, d := range vm.Disks {
+		if d.Boot {
+			boot = &vm.Disks[i]
+			continue
+		}
+		byName[d.Name] = d
+	}
+
+	bootDisk, d := bootDiskFromObject(ctx, model.BootDisk)
+	diags.Append(d...)
+	if bootDisk != nil {
+		bootDisk.DiskID = types.StringValue(vm.BootDiskID)
+		bootDisk.Size = sizeValue(bootDisk.Size, vm.DiskSize)
+		bootDisk.Image = imageValue(bootDisk.Image, vm.Image)
+		if boot != nil {
+			bootDisk.StorageClass = types.StringValue(boot.StorageClass)
+		} else if bootDisk.StorageClass.IsUnknown() {
+			bootDisk.StorageClass = types.StringNull()
+		}
+		model.BootDisk, d = bootDiskObject(ctx, bootDisk)
+		diags.Append(d...)
+	}
+
+	current, d := dataDisksFromList(ctx, model.DataDisks)
+	diags.Append(d...)
+	if current == nil {
+		return diags
+	}
+	dataDisks := make([]DataDiskModel, 0, len(current))
+	for _, disk := range current {
+		api, ok := byName[disk.Name.ValueString()]
+		if !ok {
+			continue
+		}
+		dataDisks = append(dataDisks, DataDiskModel{
+			Name:         types.StringValue(api.Name),
+			Size:         sizeValue(disk.Size, api.Size),
+			StorageClass: types.StringValue(api.StorageClass),
+			DiskID:       types.StringValue(api.ID),
+		})
+	}
+	model.DataDisks, d = dataDisksList(ctx, dataDisks)
+	diags.Append(d...)
+	return diags
+}
+
+// sizeValue возвращает размер из API, но сохраняет текущее значение, если оно задает
+// тот же размер в другой записи (20G в конфигурации при 20Gi в API не считается дрифтом)
+func sizeValue(current types.String, apiSize string) types.String {
+	if !current.IsNull() && !current.IsUnknown() && quantity.Equal(current.ValueString(), apiSize) {
+		return current
+	}
+	return types.StringValue(apiSize)
+}
+
+// imageValue возвращает образ из API, только если текущее значение не известно (создание без
+// образа в конфигурации, импорт): API может вернуть ID образа, заданного в конфигурации по имени
+func imageValue(current types.String, apiImage string) types.String {
+	if !current.IsNull() && !current.IsUnknown() {
+		return current
+	}
+	return types.StringValue(apiImage)
+}
@@ -0,0 +1,54 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"h3terraform/internal/client"
+)
+
+// vmErrorEventsLimit - сколько последних событий VM добавлять в диагностику при ошибке
+const vmErrorEventsLimit = 10
+
+// listVMEvents возвращает последние события VM (в хронологическом порядке)
+func listVMEvents(ctx context.Context, c *client.Client, vmID string, limit int) ([]VMEvent, error) {
+	queryParams := map[string]string{
+		"limit": strconv.Itoa(limit),
+	}
+
+	var listResp VMEventListResponse
+	if err := c.Do(ctx, "GET", "/api/vms/v1/"+vmID+"/events", queryParams, nil, &listResp); err != nil {
+		return nil, err
+	}
+
+	events := listResp.Events
+	if len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+	return events, nil
+}
+
+// vmFailure формирует ошибку для VM в статусе ERROR: причина от backend и последние события VM
+// (ошибка чтения событий не скрывает исходную ошибку)
+func (r *VMResource) vmFailure(ctx context.Context, vm VM) error {
+	err := vmError(vm)
+
+	events, evErr := listVMEvents(ctx, r.client, vm.ID, vmErrorEventsLimit)
+	if evErr != nil {
+		log.Printf("[WARN] could not read events of VM %s: %s", vm.ID, evErr)
+		return err
+	}
+	if len(events) == 0 {
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\nRecent VM events:")
+	for _, e := range events {
+		fmt.Fprintf(&b, "\n  %s  %s  %s: %s", e.Timestamp, e.Type, e.Reason, e.Message)
+	}
+	return fmt.Errorf("%w%s", err, b.String())
+}
@@ -0,0 +1,87 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"h3terraform/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getFIP возвращает публичный IP (FIP) VM или nil, если он не назначен
+func getFIP(ctx context.Context, c *client.Client, vmID string) (*FIP, error) {
+	var fip FIP
+	err := c.Do(ctx, "GET", "/api/vms/v1/"+vmID+"/fip", nil, nil, &fip)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read public IP of VM: %w", err)
+	}
+	return &fip, nil
+}
+
+// modifyPlanPublicIP помечает public_ip неизвестным, если white_ip меняется
+func (r *VMResource) modifyPlanPublicIP(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var planWhiteIP, stateWhiteIP types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("white_ip"), &planWhiteIP)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("white_ip"), &stateWhiteIP)...)
+	if resp.Diagnostics.HasError() || planWhiteIP.Equal(stateWhiteIP) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_ip"), types.StringUnknown())...)
+}
+
+// setWhiteIP включает или выключает публичный IP VM и ждет, пока FIP появится или будет удален
+func (r *VMResource) setWhiteIP(ctx context.Context, vmID string, enable bool, timeout time.Duration) (*FIP, error) {
+	method := "DELETE"
+	if enable {
+		method = "POST"
+	}
+	if err := r.client.Do(ctx, method, "/api/vms/v1/"+vmID+"/fip", nil, nil, nil); err != nil {
+		if httpErr, ok := err.(*client.HTTPError); !ok || enable || !httpErr.IsNotFound() {
+			return nil, fmt.Errorf("could not update public IP of VM: %w", err)
+		}
+	}
+	return r.waitForFIP(ctx, vmID, enable, timeout)
+}
+
+// waitForFIP ждет, пока у VM появится FIP с адресом (или пока FIP будет удален)
+func (r *VMResource) waitForFIP(ctx context.Context, vmID string, present bool, timeout time.Duration) (*FIP, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		fip, err := getFIP(ctx, r.client, vmID)
+		if err != nil {
+			return nil, err
+		}
+		if fip != nil {
+			log.Printf("[DEBUG] waitForFIP: VM %s FIP status=%s, ip=%s", vmID, fip.Status, fip.IPAddress)
+		}
+		if !present && fip == nil {
+			return nil, nil
+		}
+		if present && fip != nil && fip.IPAddress != "" {
+			return fip, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout waiting for public IP of VM %s", vmID)
+		case <-ticker.C:
+		}
+	}
+}
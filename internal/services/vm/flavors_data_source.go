@@ -0,0 +1,223 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &FlavorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &FlavorsDataSource{}
+)
+
+// NewFlavorsDataSource создает новый data source списка flavors
+func NewFlavorsDataSource() datasource.DataSource {
+	return &FlavorsDataSource{}
+}
+
+// FlavorsDataSource - data source для получения допустимых конфигураций CPU/RAM
+type FlavorsDataSource struct {
+	client *client.Client
+}
+
+// FlavorsDataSourceModel - модель состояния data source
+type FlavorsDataSourceModel struct {
+	NameRegex types.String  `tfsdk:"name_regex"`
+	CPU       types.Int64   `tfsdk:"cpu"`
+	Memory    types.String  `tfsdk:"memory"`
+	Names     types.List    `tfsdk:"names"`
+	Flavors   []FlavorModel `tfsdk:"flavors"`
+}
+
+// FlavorModel - модель flavor в списке
+type FlavorModel struct {
+	ID            types.String  `tfsdk:"id"`
+	Name          types.String  `tfsdk:"name"`
+	CPU           types.Int64   `tfsdk:"cpu"`
+	Memory        types.String  `tfsdk:"memory"`
+	PricePerHour  types.Float64 `tfsdk:"price_per_hour"`
+	PricePerMonth types.Float64 `tfsdk:"price_per_month"`
+	Currency      types.String  `tfsdk:"currency"`
+}
+
+// Metadata возвращает метаданные data source
+func (d *FlavorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_flavors"
+}
+
+// Schema определяет схему data source
+func (d *FlavorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the allowed VM shapes (CPU/memory combinations) and their prices",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression the flavor name must match",
+				Optional:            true,
+			},
+			"cpu": schema.Int64Attribute{
+				MarkdownDescription: "Only return flavors with this number of CPU cores",
+				Optional:            true,
+			},
+			"memory": schema.StringAttribute{
+				MarkdownDescription: "Only return flavors with this memory size (e.g., 4Gi)",
+				Optional:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Names of the matching flavors, usable as `h3_vm.flavor`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"flavors": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching flavors",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Flavor ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Flavor name",
+							Computed:            true,
+						},
+						"cpu": schema.Int64Attribute{
+							MarkdownDescription: "Number of CPU cores",
+							Computed:            true,
+						},
+						"memory": schema.StringAttribute{
+							MarkdownDescription: "Memory size",
+							Computed:            true,
+						},
+						"price_per_hour": schema.Float64Attribute{
+							MarkdownDescription: "Price per hour",
+							Computed:            true,
+						},
+						"price_per_month": schema.Float64Attribute{
+							MarkdownDescription: "Price per month",
+							Computed:            true,
+						},
+						"currency": schema.StringAttribute{
+							MarkdownDescription: "Price currency",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure инициализирует data source с клиентом
+func (d *FlavorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read получает список flavors и применяет фильтр
+func (d *FlavorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config FlavorsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, types.StringNull(), types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flavors, err := listFlavors(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing VM flavors",
+			fmt.Sprintf("Could not list VM flavors: %s", err.Error()),
+		)
+		return
+	}
+
+	names := []string{}
+	config.Flavors = []FlavorModel{}
+	for _, flavor := range flavors {
+		if !f.Match(flavor.Name, "", nil) {
+			continue
+		}
+		if !config.CPU.IsNull() && int64(flavor.CPU) != config.CPU.ValueInt64() {
+			continue
+		}
+		if !config.Memory.IsNull() && flavor.Memory != config.Memory.ValueString() {
+			continue
+		}
+
+		names = append(names, flavor.Name)
+		config.Flavors = append(config.Flavors, FlavorModel{
+			ID:            types.StringValue(flavor.ID),
+			Name:          types.StringValue(flavor.Name),
+			CPU:           types.Int64Value(int64(flavor.CPU)),
+			Memory:        types.StringValue(flavor.Memory),
+			PricePerHour:  types.Float64Value(flavor.PricePerHour),
+			PricePerMonth: types.Float64Value(flavor.PricePerMonth),
+			Currency:      types.StringValue(flavor.Currency),
+		})
+	}
+
+	namesList, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	config.Names = namesList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listFlavors возвращает все доступные flavors
+func listFlavors(ctx context.Context, c *client.Client) ([]Flavor, error) {
+	queryParams := map[string]string{}
+
+	// Backend отдает список постранично, идем по next_page_token до конца
+	var flavors []Flavor
+	for {
+		var listResp FlavorListResponse
+		if err := c.Do(ctx, "GET", "/api/vms/v1/flavors", queryParams, nil, &listResp); err != nil {
+			return nil, err
+		}
+
+		flavors = append(flavors, listResp.Flavors...)
+		if listResp.NextPageToken == "" {
+			return flavors, nil
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+}
+
+// findFlavor ищет flavor по имени или ID
+func findFlavor(ctx context.Context, c *client.Client, nameOrID string) (*Flavor, error) {
+	flavors, err := listFlavors(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, flavor := range flavors {
+		if flavor.Name == nameOrID || flavor.ID == nameOrID {
+			return &flavor, nil
+		}
+	}
+	return nil, fmt.Errorf("VM flavor %q not found, see the h3_vm_flavors data source for allowed values", nameOrID)
+}
@@ -0,0 +1,633 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vmGroupInstanceTimeout - сколько ждать готовности или удаления VM группы
+const vmGroupInstanceTimeout = 10 * time.Minute
+
+// Статусы VM группы, которые уже не считаются ее членами
+const (
+	vmGroupInstanceDeleting = "DELETING"
+	vmGroupInstanceDeleted  = "DELETED"
+)
+
+var (
+	_ resource.Resource                = &VMGroupResource{}
+	_ resource.ResourceWithConfigure   = &VMGroupResource{}
+	_ resource.ResourceWithImportState = &VMGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &VMGroupResource{}
+)
+
+// NewVMGroupResource создает новый ресурс группы VM
+func NewVMGroupResource() resource.Resource {
+	return &VMGroupResource{}
+}
+
+// VMGroupResource - группа одинаковых VM из шаблона с постепенным обновлением
+type VMGroupResource struct {
+	client *client.Client
+}
+
+// VMGroupResourceModel - модель состояния ресурса
+type VMGroupResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Name           types.String `tfsdk:"name"`
+	TemplateID     types.String `tfsdk:"template_id"`
+	Size           types.Int64  `tfsdk:"size"`
+	SubnetID       types.String `tfsdk:"subnet_id"`
+	MaxSurge       types.Int64  `tfsdk:"max_surge"`
+	MaxUnavailable types.Int64  `tfsdk:"max_unavailable"`
+	InstanceIDs    types.List   `tfsdk:"instance_ids"`
+	CurrentSize    types.Int64  `tfsdk:"current_size"`
+	Status         types.String `tfsdk:"status"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Labels         types.Map    `tfsdk:"labels"`
+	LabelsAll      types.Map    `tfsdk:"labels_all"`
+}
+
+// Metadata возвращает метаданные ресурса
+func (r *VMGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_group"
+}
+
+// Schema определяет схему ресурса
+func (r *VMGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Group of identical VMs created from an `h3_vm_template`. Changing `template_id` replaces the VMs gradually in batches limited by `max_surge` and `max_unavailable`; every new VM must become ready before the next batch starts",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "VM group ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID (UUID)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Group name, also used as the prefix of VM names",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the `h3_vm_template` to create VMs from. Changing it triggers a rolling update",
+				Required:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Number of VMs in the group. Ignored while an `h3_vm_autoscaler` is attached to the group",
+				Required:            true,
+			},
+			"subnet_id": schema.StringAttribute{
+				MarkdownDescription: "Subnet ID (`h3_ovn_network.subnet_id`) the VMs are attached to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_surge": schema.Int64Attribute{
+				MarkdownDescription: "How many VMs above `size` may be created during a rolling update (default: 1)",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"max_unavailable": schema.Int64Attribute{
+				MarkdownDescription: "How many VMs below `size` may be missing during a rolling update (default: 0)",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"instance_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the VMs in the group",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"current_size": schema.Int64Attribute{
+				MarkdownDescription: "Actual number of VMs in the group, including changes made by an autoscaler",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "VM group status",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels":     labels.Attribute(),
+			"labels_all": labels.AllAttribute(),
+		},
+	}
+}
+
+// Configure инициализирует ресурс с клиентом
+func (r *VMGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan вычисляет labels_all и проверяет size и параметры rolling update
+func (r *VMGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan VMGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Size.IsUnknown() && plan.Size.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("size"),
+			"Invalid size",
+			"size must not be negative",
+		)
+	}
+
+	if plan.MaxSurge.IsUnknown() || plan.MaxUnavailable.IsUnknown() {
+		return
+	}
+	if plan.MaxSurge.ValueInt64() < 0 || plan.MaxUnavailable.ValueInt64() < 0 {
+		resp.Diagnostics.AddError(
+			"Invalid rolling update settings",
+			"max_surge and max_unavailable must not be negative",
+		)
+		return
+	}
+	if plan.MaxSurge.ValueInt64()+plan.MaxUnavailable.ValueInt64() == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid rolling update settings",
+			"At least one of max_surge or max_unavailable must be greater than 0, otherwise a rolling update cannot make progress",
+		)
+	}
+}
+
+// Create создает группу и ждет готовности всех VM
+func (r *VMGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VMGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := CreateVMGroupRequest{
+		ProjectID:  plan.ProjectID.ValueString(),
+		Name:       plan.Name.ValueString(),
+		TemplateID: plan.TemplateID.ValueString(),
+		SubnetID:   plan.SubnetID.ValueString(),
+		TargetSize: int(plan.Size.ValueInt64()),
+		Labels:     allLabels,
+	}
+
+	var group VMGroup
+	err := r.client.Do(ctx, "POST", "/api/vms/v1/groups", nil, createReq, &group)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating VM group",
+			"Could not create VM group: "+err.Error(),
+		)
+		return
+	}
+
+	// Сохраняем ID сразу, чтобы группа не потерялась, если ожидание упадет
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(group.ID))...)
+
+	group, err = r.waitForVMGroupSize(ctx, group.ID, createReq.TargetSize, 30*time.Minute)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for VM group",
+			"VM group created but its VMs are not ready: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(group.ID)
+	setVMGroupFromAPI(&plan, group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read читает текущее состояние группы VM
+func (r *VMGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VMGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.getVMGroup(ctx, state.ID.ValueString())
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading VM group", err.Error())
+		return
+	}
+
+	state.ProjectID = types.StringValue(group.ProjectID)
+	state.Name = types.StringValue(group.Name)
+	state.SubnetID = types.StringValue(group.SubnetID)
+	// С автоскейлером текущий размер меняется сам, size из конфигурации не сравниваем с ним
+	if !group.Autoscaled || state.Size.IsNull() {
+		state.Size = types.Int64Value(int64(group.TargetSize))
+	}
+	state.TemplateID = types.StringValue(group.TemplateID)
+	// Если rolling update был прерван, часть VM осталась на старом шаблоне:
+	// показываем старый шаблон, чтобы следующий apply довел обновление до конца
+	if outdated := outdatedInstances(group); len(outdated) > 0 {
+		state.TemplateID = types.StringValue(outdated[0].TemplateID)
+	}
+	// Параметры rolling update хранятся только в state, при импорте берем значения по умолчанию
+	if state.MaxSurge.IsNull() {
+		state.MaxSurge = types.Int64Value(1)
+	}
+	if state.MaxUnavailable.IsNull() {
+		state.MaxUnavailable = types.Int64Value(0)
+	}
+	setVMGroupFromAPI(&state, group)
+
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, group.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update меняет размер, шаблон и labels группы; смена шаблона выполняется как rolling update
+func (r *VMGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VMGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := state.ID.ValueString()
+
+	var updateReq UpdateVMGroupRequest
+	changed := false
+
+	if !plan.TemplateID.Equal(state.TemplateID) {
+		templateID := plan.TemplateID.ValueString()
+		updateReq.TemplateID = &templateID
+		changed = true
+	}
+	sizeChanged := !plan.Size.Equal(state.Size)
+	if sizeChanged {
+		current, err := r.getVMGroup(ctx, groupID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading VM group", err.Error())
+			return
+		}
+		// Размером группы с автоскейлером управляет h3_vm_autoscaler
+		if current.Autoscaled {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("size"),
+				"VM group size is managed by an autoscaler",
+				"The group has an h3_vm_autoscaler attached, so size is ignored. Change min_size/max_size of the autoscaler instead",
+			)
+			sizeChanged = false
+		}
+	}
+	if sizeChanged {
+		size := int(plan.Size.ValueInt64())
+		updateReq.TargetSize = &size
+		changed = true
+	}
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if allLabels == nil {
+			allLabels = map[string]string{}
+		}
+		updateReq.Labels = &allLabels
+		changed = true
+	}
+
+	if changed {
+		err := r.client.Do(ctx, "PATCH", "/api/vms/v1/groups/"+groupID, nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating VM group",
+				"Could not update VM group: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var group VMGroup
+	var err error
+	if sizeChanged {
+		group, err = r.waitForVMGroupSize(ctx, groupID, int(plan.Size.ValueInt64()), 30*time.Minute)
+	} else {
+		group, err = r.getVMGroup(ctx, groupID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for VM group",
+			"VM group was updated but its VMs are not ready: "+err.Error(),
+		)
+		return
+	}
+
+	// Новый шаблон применяется только к новым VM, старые заменяем партиями
+	group, err = r.rollingUpdate(ctx, group, int(plan.MaxSurge.ValueInt64()), int(plan.MaxUnavailable.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error during VM group rolling update",
+			"Rolling update stopped: "+err.Error()+". The remaining VMs still run the previous template; the next apply resumes the update",
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt = state.CreatedAt
+	setVMGroupFromAPI(&plan, group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete удаляет группу вместе с ее VM
+func (r *VMGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VMGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Do(ctx, "DELETE", "/api/vms/v1/groups/"+state.ID.ValueString(), nil, nil, nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting VM group", err.Error())
+		return
+	}
+
+	// Ждем удаления VM, иначе шаблон группы нельзя будет удалить
+	if err := r.waitForVMGroupDeleted(ctx, state.ID.ValueString(), 30*time.Minute); err != nil {
+		resp.Diagnostics.AddError("Error waiting for VM group deletion", err.Error())
+	}
+}
+
+// ImportState импортирует группу VM по ID
+func (r *VMGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getVMGroup читает группу VM
+func (r *VMGroupResource) getVMGroup(ctx context.Context, id string) (VMGroup, error) {
+	var group VMGroup
+	err := r.client.Do(ctx, "GET", "/api/vms/v1/groups/"+id, nil, nil, &group)
+	return group, err
+}
+
+// rollingUpdate заменяет VM на старом шаблоне партиями: сначала создает до max_surge новых VM,
+// затем удаляет партию старых, ждет их удаления и добирает оставшиеся новые. Каждая новая VM должна
+// стать готовой до перехода к следующему шагу, поэтому VM меньше size - max_unavailable не бывает,
+// а больше size + max_surge - только пока удаляемые VM еще не исчезли из группы
+func (r *VMGroupResource) rollingUpdate(ctx context.Context, group VMGroup, maxSurge, maxUnavailable int) (VMGroup, error) {
+	for {
+		outdated := outdatedInstances(group)
+		if len(outdated) == 0 {
+			return group, nil
+		}
+
+		batch := min(maxSurge+maxUnavailable, len(outdated))
+		surge := min(maxSurge, batch)
+
+		log.Printf("[DEBUG] rollingUpdate: group %s, %d outdated VMs, batch=%d surge=%d", group.ID, len(outdated), batch, surge)
+
+		if err := r.addInstances(ctx, group.ID, surge); err != nil {
+			return group, err
+		}
+		for _, instance := range outdated[:batch] {
+			err := r.client.Do(ctx, "DELETE", "/api/vms/v1/groups/"+group.ID+"/instances/"+instance.VMID, nil, nil, nil)
+			if err != nil {
+				if httpErr, ok := err.(*client.HTTPError); !ok || !httpErr.IsNotFound() {
+					return group, fmt.Errorf("could not delete VM %s: %w", instance.VMID, err)
+				}
+			}
+		}
+		if err := r.waitForInstancesDeleted(ctx, group.ID, outdated[:batch], vmGroupInstanceTimeout); err != nil {
+			return group, err
+		}
+		if err := r.addInstances(ctx, group.ID, batch-surge); err != nil {
+			return group, err
+		}
+
+		var err error
+		group, err = r.getVMGroup(ctx, group.ID)
+		if err != nil {
+			return group, err
+		}
+	}
+}
+
+// addInstances создает count VM из текущего шаблона группы и ждет их готовности
+func (r *VMGroupResource) addInstances(ctx context.Context, groupID string, count int) error {
+	instances := make([]VMGroupInstance, 0, count)
+	for i := 0; i < count; i++ {
+		var instance VMGroupInstance
+		if err := r.client.Do(ctx, "POST", "/api/vms/v1/groups/"+groupID+"/instances", nil, nil, &instance); err != nil {
+			return fmt.Errorf("could not create VM: %w", err)
+		}
+		instances = append(instances, instance)
+	}
+	return r.waitForInstances(ctx, instances)
+}
+
+// waitForInstances ждет готовности VM группы тем же опросом, что и h3_vm
+func (r *VMGroupResource) waitForInstances(ctx context.Context, instances []VMGroupInstance) error {
+	vms := &VMResource{client: r.client}
+	for _, instance := range instances {
+		if err := vms.waitForVMReady(ctx, instance.VMID, false, vmGroupInstanceTimeout); err != nil {
+			return fmt.Errorf("VM %s: %w", instance.VMID, err)
+		}
+	}
+	return nil
+}
+
+// waitForInstancesDeleted ждет, пока удаленные VM исчезнут из группы
+func (r *VMGroupResource) waitForInstancesDeleted(ctx context.Context, groupID string, instances []VMGroupInstance, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for %d VMs of group %s to be deleted", len(instances), groupID)
+		case <-ticker.C:
+			group, err := r.getVMGroup(ctx, groupID)
+			if err != nil {
+				return err
+			}
+
+			present := make(map[string]bool, len(group.Instances))
+			for _, instance := range group.Instances {
+				if instance.Status != vmGroupInstanceDeleted {
+					present[instance.VMID] = true
+				}
+			}
+			remaining := 0
+			for _, instance := range instances {
+				if present[instance.VMID] {
+					remaining++
+				}
+			}
+
+			log.Printf("[DEBUG] waitForInstancesDeleted: group %s, %d/%d VMs still present", groupID, remaining, len(instances))
+			if remaining == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// waitForVMGroupSize ждет, пока в группе будет size VM (не считая удаляемых), и затем готовности каждой из них
+func (r *VMGroupResource) waitForVMGroupSize(ctx context.Context, id string, size int, timeout time.Duration) (VMGroup, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return VMGroup{}, fmt.Errorf("timeout waiting for VM group %s to reach %d VMs", id, size)
+		case <-ticker.C:
+			group, err := r.getVMGroup(ctx, id)
+			if err != nil {
+				return VMGroup{}, err
+			}
+
+			instances := activeInstances(group)
+			log.Printf("[DEBUG] waitForVMGroupSize: %s Status=%s, %d/%d VMs", id, group.Status, len(instances), size)
+
+			if len(instances) != size {
+				continue
+			}
+			if err := r.waitForInstances(ctx, instances); err != nil {
+				return VMGroup{}, err
+			}
+			return r.getVMGroup(ctx, id)
+		}
+	}
+}
+
+// waitForVMGroupDeleted ждет, пока backend удалит группу и ее VM
+func (r *VMGroupResource) waitForVMGroupDeleted(ctx context.Context, id string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for VM group %s to be deleted", id)
+		case <-ticker.C:
+			if _, err := r.getVMGroup(ctx, id); err != nil {
+				if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+}
+
+// activeInstances возвращает VM группы без удаляемых и уже удаленных
+func activeInstances(group VMGroup) []VMGroupInstance {
+	var active []VMGroupInstance
+	for _, instance := range group.Instances {
+		if instance.Status != vmGroupInstanceDeleting && instance.Status != vmGroupInstanceDeleted {
+			active = append(active, instance)
+		}
+	}
+	return active
+}
+
+// outdatedInstances возвращает VM, созданные не из текущего шаблона группы (без удаляемых)
+func outdatedInstances(group VMGroup) []VMGroupInstance {
+	var outdated []VMGroupInstance
+	for _, instance := range activeInstances(group) {
+		if instance.TemplateID != group.TemplateID {
+			outdated = append(outdated, instance)
+		}
+	}
+	return outdated
+}
+
+// setVMGroupFromAPI заполняет computed атрибуты группы VM из ответа API
+func setVMGroupFromAPI(model *VMGroupResourceModel, group VMGroup) {
+	instances := activeInstances(group)
+	ids := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		ids = append(ids, instance.VMID)
+	}
+
+	model.InstanceIDs = stringListValue(ids)
+	model.CurrentSize = types.Int64Value(int64(len(instances)))
+	model.Status = types.StringValue(group.Status)
+	model.CreatedAt = types.StringValue(group.CreatedAt)
+	model.LabelsAll = labels.Value(group.Labels)
+}
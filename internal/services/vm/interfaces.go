@@ -0,0 +1,416 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkInterfaceModel - модель блока network_interface
+type NetworkInterfaceModel struct {
+	SubnetID         types.String `tfsdk:"subnet_id"`
+	IPAddress        types.String `tfsdk:"ip_address"`
+	SecurityGroupIDs types.List   `tfsdk:"security_group_ids"`
+	InterfaceID      types.String `tfsdk:"interface_id"`
+	MACAddress       types.String `tfsdk:"mac_address"`
+}
+
+// networkInterfaceAttrTypes - типы атрибутов блока network_interface
+var networkInterfaceAttrTypes = map[string]attr.Type{
+	"subnet_id":          types.StringType,
+	"ip_address":         types.StringType,
+	"security_group_ids": types.ListType{ElemType: types.StringType},
+	"interface_id":       types.StringType,
+	"mac_address":        types.StringType,
+}
+
+// interfacesFromList читает блоки network_interface (nil, если список null или еще не известен,
+// например при dynamic блоке с неизвестным for_each)
+func interfacesFromList(ctx context.Context, list types.List) ([]NetworkInterfaceModel, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	nics := make([]NetworkInterfaceModel, 0, len(list.Elements()))
+	diags := list.ElementsAs(ctx, &nics, false)
+	return nics, diags
+}
+
+// interfacesList конвертирует модели network_interface в types.List
+func interfacesList(ctx context.Context, nics []NetworkInterfaceModel) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: networkInterfaceAttrTypes}, nics)
+}
+
+// networkInterfaceBlock - схема блока network_interface
+func networkInterfaceBlock() schema.Block {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Network interfaces of the VM (alternative to subnet_name). The first one is primary and can only be changed by replacing the VM; additional interfaces are hot-plugged in place. Interfaces are matched by `subnet_id`",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"subnet_id": schema.StringAttribute{
+					MarkdownDescription: "Subnet ID (`h3_ovn_network.subnet_id`), unique within the VM",
+					Required:            true,
+				},
+				"ip_address": schema.StringAttribute{
+					MarkdownDescription: "Static private IP address; must belong to the subnet CIDR. Assigned dynamically if omitted",
+					Optional:            true,
+					Computed:            true,
+				},
+				"security_group_ids": schema.ListAttribute{
+					MarkdownDescription: "Security group IDs applied to the interface",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+				"interface_id": schema.StringAttribute{
+					MarkdownDescription: "Interface ID",
+					Computed:            true,
+				},
+				"mac_address": schema.StringAttribute{
+					MarkdownDescription: "Interface MAC address",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// modifyPlanInterfaces проверяет network_interface и переносит computed поля из state.
+// Если список еще не известен (dynamic с неизвестным for_each), проверка откладывается до apply
+func (r *VMResource) modifyPlanInterfaces(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config, plan, state VMResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.NetworkInterfaces.IsUnknown() {
+		return
+	}
+
+	configNICs, diags := interfacesFromList(ctx, config.NetworkInterfaces)
+	resp.Diagnostics.Append(diags...)
+	planNICs, diags := interfacesFromList(ctx, plan.NetworkInterfaces)
+	resp.Diagnostics.Append(diags...)
+	stateNICs, diags := interfacesFromList(ctx, state.NetworkInterfaces)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(planNICs) == 0 {
+		// Без блоков не осталось бы основного интерфейса
+		if len(stateNICs) > 0 {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("network_interface"))
+		}
+		return
+	}
+
+	if !plan.SubnetName.IsNull() {
+		resp.Diagnostics.AddError(
+			"Conflicting network settings",
+			"network_interface is mutually exclusive with subnet_name",
+		)
+		return
+	}
+
+	existing := make(map[string]NetworkInterfaceModel, len(stateNICs))
+	for _, nic := range stateNICs {
+		existing[nic.SubnetID.ValueString()] = nic
+	}
+
+	seen := make(map[string]bool, len(planNICs))
+	for i, nic := range planNICs {
+		nicPath := path.Root("network_interface").AtListIndex(i)
+		configIP := configNICs[i].IPAddress
+
+		if nic.SubnetID.IsUnknown() {
+			planNICs[i].InterfaceID = types.StringUnknown()
+			planNICs[i].MACAddress = types.StringUnknown()
+			continue
+		}
+		subnetID := nic.SubnetID.ValueString()
+		if seen[subnetID] {
+			resp.Diagnostics.AddAttributeError(
+				nicPath.AtName("subnet_id"),
+				"Duplicate network interface subnet",
+				fmt.Sprintf("subnet %q is used by more than one network interface", subnetID),
+			)
+			return
+		}
+		seen[subnetID] = true
+
+		if r.client != nil && !plan.ProjectID.IsUnknown() {
+			network, err := getNetwork(ctx, r.client, subnetID, plan.ProjectID.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(nicPath.AtName("subnet_id"), "Invalid subnet", err.Error())
+			} else if !configIP.IsNull() && !configIP.IsUnknown() {
+				if err := validateInterfaceIP(*network, configIP.ValueString()); err != nil {
+					resp.Diagnostics.AddAttributeError(nicPath.AtName("ip_address"), "Invalid interface IP address", err.Error())
+				}
+			}
+		}
+
+		old, ok := existing[subnetID]
+		if ok && configIP.IsNull() {
+			planNICs[i].IPAddress = old.IPAddress
+		}
+		if ok && planNICs[i].IPAddress.Equal(old.IPAddress) {
+			planNICs[i].InterfaceID = old.InterfaceID
+			planNICs[i].MACAddress = old.MACAddress
+			continue
+		}
+		// Новый интерфейс или смена адреса - интерфейс будет пересоздан
+		planNICs[i].InterfaceID = types.StringUnknown()
+		planNICs[i].MACAddress = types.StringUnknown()
+	}
+
+	// Основной интерфейс нельзя заменить без пересоздания VM
+	if len(stateNICs) > 0 {
+		primary, oldPrimary := planNICs[0], stateNICs[0]
+		if !primary.SubnetID.Equal(oldPrimary.SubnetID) || !primary.IPAddress.Equal(oldPrimary.IPAddress) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("network_interface").AtListIndex(0))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("network_interface"), planNICs)...)
+}
+
+// validateInterfaceIP проверяет, что адрес входит в CIDR подсети
+func validateInterfaceIP(network Network, ip string) error {
+	subnetID := network.SubnetID
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return fmt.Errorf("invalid IP address %q", ip)
+	}
+
+	prefix, err := netip.ParsePrefix(network.CIDRBlock)
+	if err != nil {
+		return fmt.Errorf("subnet %s has invalid CIDR %q", subnetID, network.CIDRBlock)
+	}
+	if !prefix.Contains(addr) {
+		return fmt.Errorf("IP address %s is outside of subnet %s (%s)", ip, subnetID, network.CIDRBlock)
+	}
+	if addr == prefix.Masked().Addr() {
+		return fmt.Errorf("IP address %s is the network address of subnet %s", ip, subnetID)
+	}
+	return nil
+}
+
+// applyInterfaceChanges подключает, отключает и обновляет дополнительные интерфейсы VM
+func (r *VMResource) applyInterfaceChanges(ctx context.Context, vmID string, plan, state []NetworkInterfaceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planned := make(map[string]NetworkInterfaceModel, len(plan))
+	for _, nic := range plan {
+		planned[nic.SubnetID.ValueString()] = nic
+	}
+
+	// Сначала отключаем удаленные и пересоздаваемые интерфейсы
+	for _, nic := range state {
+		p, ok := planned[nic.SubnetID.ValueString()]
+		if ok && p.IPAddress.Equal(nic.IPAddress) {
+			continue
+		}
+		err := r.client.Do(ctx, "DELETE", "/api/vms/v1/"+vmID+"/interfaces/"+nic.InterfaceID.ValueString(), nil, nil, nil)
+		if err != nil {
+			diags.AddError("Error detaching network interface", fmt.Sprintf("Could not detach interface in subnet %q: %s", nic.SubnetID.ValueString(), err.Error()))
+			return diags
+		}
+	}
+
+	existing := make(map[string]NetworkInterfaceModel, len(state))
+	for _, nic := range state {
+		existing[nic.SubnetID.ValueString()] = nic
+	}
+
+	for _, nic := range plan {
+		spec, d := networkInterfaceSpec(ctx, nic)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		old, ok := existing[nic.SubnetID.ValueString()]
+		if !ok || !nic.IPAddress.Equal(old.IPAddress) {
+			if err := r.client.Do(ctx, "POST", "/api/vms/v1/"+vmID+"/interfaces", nil, spec, nil); err != nil {
+				diags.AddError("Error attaching network interface", fmt.Sprintf("Could not attach interface in subnet %q: %s", spec.SubnetID, err.Error()))
+				return diags
+			}
+			continue
+		}
+
+		if !nic.SecurityGroupIDs.Equal(old.SecurityGroupIDs) {
+			updateReq := UpdateVMInterfaceRequest{SecurityGroupIDs: spec.SecurityGroupIDs}
+			if updateReq.SecurityGroupIDs == nil {
+				updateReq.SecurityGroupIDs = []string{}
+			}
+			err := r.client.Do(ctx, "PATCH", "/api/vms/v1/"+vmID+"/interfaces/"+old.InterfaceID.ValueString(), nil, updateReq, nil)
+			if err != nil {
+				diags.AddError("Error updating network interface", fmt.Sprintf("Could not update interface in subnet %q: %s", spec.SubnetID, err.Error()))
+				return diags
+			}
+		}
+	}
+
+	// Hot-plug асинхронный - ждем, чтобы финальный GET совпал с планом
+	if err := r.waitForVMInterfaces(ctx, vmID, plan, 10*time.Minute); err != nil {
+		diags.AddError("Error waiting for network interfaces", "Network interface changes were requested but not applied: "+err.Error())
+	}
+
+	return diags
+}
+
+// waitForVMInterfaces ждет, пока интерфейсы VM совпадут с планом: новые подключены
+// (с заданным адресом), а удаленные отключены
+func (r *VMResource) waitForVMInterfaces(ctx context.Context, vmID string, nics []NetworkInterfaceModel, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for network interfaces of VM %s", vmID)
+		case <-ticker.C:
+			var vm VM
+			if err := r.client.Do(ctx, "GET", "/api/vms/v1/"+vmID, nil, nil, &vm); err != nil {
+				return err
+			}
+			if vm.Status == "ERROR" {
+				return r.vmFailure(ctx, vm)
+			}
+
+			pending := interfacesPending(nics, vm.Interfaces)
+			log.Printf("[DEBUG] waitForVMInterfaces: VM %s pending interfaces in subnets: %v", vmID, pending)
+			if len(pending) == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// interfacesPending возвращает подсети, интерфейсы в которых еще не совпадают с планом
+// (основной интерфейс не отключается, поэтому лишним не считается; в подсети допускается один интерфейс)
+func interfacesPending(nics []NetworkInterfaceModel, apiInterfaces []VMInterface) []string {
+	var pending []string
+	planned := make(map[string]bool, len(nics))
+	for _, nic := range nics {
+		subnetID := nic.SubnetID.ValueString()
+		planned[subnetID] = true
+
+		found := false
+		for _, api := range apiInterfaces {
+			if api.SubnetID != subnetID {
+				continue
+			}
+			if nic.IPAddress.IsNull() || nic.IPAddress.IsUnknown() || api.IPAddress == nic.IPAddress.ValueString() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			pending = append(pending, subnetID)
+		}
+	}
+	// Отключенный при смене адреса интерфейс не должен оставаться рядом с новым
+	count := make(map[string]int, len(apiInterfaces))
+	for _, api := range apiInterfaces {
+		count[api.SubnetID]++
+		if (!api.Primary && !planned[api.SubnetID]) || count[api.SubnetID] == 2 {
+			pending = append(pending, api.SubnetID)
+		}
+	}
+	return pending
+}
+
+// interfacesChanged проверяет, отличается ли набор интерфейсов в plan и state
+func interfacesChanged(plan, state []NetworkInterfaceModel) bool {
+	if len(plan) != len(state) {
+		return true
+	}
+	existing := make(map[string]NetworkInterfaceModel, len(state))
+	for _, nic := range state {
+		existing[nic.SubnetID.ValueString()] = nic
+	}
+	for _, nic := range plan {
+		old, ok := existing[nic.SubnetID.ValueString()]
+		if !ok || !nic.IPAddress.Equal(old.IPAddress) || !nic.SecurityGroupIDs.Equal(old.SecurityGroupIDs) {
+			return true
+		}
+	}
+	return false
+}
+
+// networkInterfaceSpec формирует параметры интерфейса для запроса к API
+func networkInterfaceSpec(ctx context.Context, nic NetworkInterfaceModel) (NetworkInterfaceSpec, diag.Diagnostics) {
+	spec := NetworkInterfaceSpec{SubnetID: nic.SubnetID.ValueString()}
+	if !nic.IPAddress.IsNull() && !nic.IPAddress.IsUnknown() {
+		spec.IPAddress = nic.IPAddress.ValueString()
+	}
+	var diags diag.Diagnostics
+	if !nic.SecurityGroupIDs.IsNull() && !nic.SecurityGroupIDs.IsUnknown() {
+		diags = nic.SecurityGroupIDs.ElementsAs(ctx, &spec.SecurityGroupIDs, false)
+	}
+	return spec, diags
+}
+
+// networkInterfaceSpecs формирует параметры интерфейсов для запроса создания
+func networkInterfaceSpecs(ctx context.Context, nics []NetworkInterfaceModel) ([]NetworkInterfaceSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	specs := make([]NetworkInterfaceSpec, 0, len(nics))
+	for _, nic := range nics {
+		spec, d := networkInterfaceSpec(ctx, nic)
+		diags.Append(d...)
+		specs = append(specs, spec)
+	}
+	return specs, diags
+}
+
+// setInterfacesFromAPI заполняет network_interface из ответа API
+// (интерфейсы, которых больше нет в API, удаляются из state - план подключит их заново)
+func setInterfacesFromAPI(ctx context.Context, model *VMResourceModel, vm VM) diag.Diagnostics {
+	current, diags := interfacesFromList(ctx, model.NetworkInterfaces)
+	if current == nil || diags.HasError() {
+		return diags
+	}
+
+	bySubnet := make(map[string]VMInterface, len(vm.Interfaces))
+	for _, nic := range vm.Interfaces {
+		bySubnet[nic.SubnetID] = nic
+	}
+
+	nics := make([]NetworkInterfaceModel, 0, len(current))
+	for _, nic := range current {
+		api, ok := bySubnet[nic.SubnetID.ValueString()]
+		if !ok {
+			continue
+		}
+		// security groups по умолчанию, назначенные backend, не считаем дрифтом
+		securityGroupIDs := nic.SecurityGroupIDs
+		if !securityGroupIDs.IsNull() {
+			securityGroupIDs = stringListValue(api.SecurityGroupIDs)
+		}
+		nics = append(nics, NetworkInterfaceModel{
+			SubnetID:         types.StringValue(api.SubnetID),
+			IPAddress:        types.StringValue(api.IPAddress),
+			SecurityGroupIDs: securityGroupIDs,
+			InterfaceID:      types.StringValue(api.ID),
+			MACAddress:       types.StringValue(api.MACAddress),
+		})
+	}
+
+	var d diag.Diagnostics
+	model.NetworkInterfaces, d = interfacesList(ctx, nics)
+	diags.Append(d...)
+	return diags
+}
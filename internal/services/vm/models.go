@@ -2,24 +2,41 @@ package vm
 
 // CreateVMRequest - DTO для создания VM (соответствует h3vm/internal/publicapi/http/dto.go)
 type CreateVMRequest struct {
-	ProjectID        string `json:"project_id"`
-	Name             string `json:"name"`
-	CPU              int    `json:"cpu"`
-	Memory           string `json:"memory"`
-	DiskSize         string `json:"disk_size,omitempty"`
-	Image            string `json:"image,omitempty"`
-	SSHKey           string `json:"ssh_key,omitempty"`
-	SSHKeyID         string `json:"ssh_key_id,omitempty"`
-	SubnetName       string `json:"subnet_name,omitempty"`
-	WhiteIP          bool   `json:"white_ip"`
-	SourceSnapshotID string `json:"source_snapshot_id,omitempty"`
-	SourceBackupID   string `json:"source_backup_id,omitempty"`
+	ProjectID        string         `json:"project_id"`
+	Name             string         `json:"name"`
+	CPU              int            `json:"cpu"`
+	Memory           string         `json:"memory"`
+	DiskSize         string         `json:"disk_size,omitempty"`
+	Image            string         `json:"image,omitempty"`
+	SSHKey           string         `json:"ssh_key,omitempty"`
+	SSHKeyID         string         `json:"ssh_key_id,omitempty"`
+	SubnetName       string         `json:"subnet_name,omitempty"`
+	WhiteIP          bool           `json:"white_ip"`
+	SourceSnapshotID string         `json:"source_snapshot_id,omitempty"`
+	SourceBackupID   string         `json:"source_backup_id,omitempty"`
+	SourceDiskID     string         `json:"source_disk_id,omitempty"`
+	BootDisk         *BootDiskSpec  `json:"boot_disk,omitempty"`
+	DataDisks        []DataDiskSpec `json:"data_disks,omitempty"`
+	// NetworkInterfaces - интерфейсы VM, первый основной (вместо SubnetName)
+	NetworkInterfaces []NetworkInterfaceSpec `json:"network_interfaces,omitempty"`
+	PlacementGroupID  string                 `json:"placement_group_id,omitempty"`
+	UserData          string                 `json:"user_data,omitempty"`
+	Metadata          map[string]string      `json:"metadata,omitempty"`
+	Labels            map[string]string      `json:"labels,omitempty"`
+	// DeletionProtection - защита от удаления на стороне backend
+	DeletionProtection bool `json:"deletion_protection,omitempty"`
 }
 
-// UpdateVMRequest - DTO для обновления VM (CPU/RAM)
+// UpdateVMRequest - DTO для обновления VM (CPU/RAM/размер boot диска/user data/metadata/labels/deletion_protection)
 type UpdateVMRequest struct {
-	CPU    *int    `json:"cpu,omitempty"`
-	Memory *string `json:"memory,omitempty"`
+	CPU      *int               `json:"cpu,omitempty"`
+	Memory   *string            `json:"memory,omitempty"`
+	DiskSize *string            `json:"disk_size,omitempty"`
+	UserData *string            `json:"user_data,omitempty"`
+	Metadata *map[string]string `json:"metadata,omitempty"`
+	Labels   *map[string]string `json:"labels,omitempty"`
+
+	DeletionProtection *bool `json:"deletion_protection,omitempty"`
 }
 
 // VM - ответ от API
@@ -35,4 +52,312 @@ type VM struct {
 	Endpoint   string `json:"endpoint"`
 	WhiteIP    bool   `json:"white_ip"`
 	SubnetName string `json:"subnet_name,omitempty"`
+	// Сетевые параметры основного интерфейса
+	SubnetID      string            `json:"subnet_id,omitempty"`
+	PrivateIP     string            `json:"private_ip,omitempty"`
+	PublicIP      string            `json:"public_ip,omitempty"`
+	IPv6Addresses []string          `json:"ipv6_addresses,omitempty"`
+	MACAddress    string            `json:"mac_address,omitempty"`
+	BootDiskID    string            `json:"boot_disk_id,omitempty"`
+	Disks         []VMDisk          `json:"disks,omitempty"`
+	Interfaces    []VMInterface     `json:"interfaces,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	// PlacementGroupID - группа размещения VM
+	PlacementGroupID string `json:"placement_group_id,omitempty"`
+	// StatusReason/StatusMessage - причина статуса ERROR (например, PLACEMENT_FAILED)
+	StatusReason  string `json:"status_reason,omitempty"`
+	StatusMessage string `json:"status_message,omitempty"`
+	// DeletionProtection - nil, если backend не сообщает флаг
+	DeletionProtection *bool `json:"deletion_protection,omitempty"`
+}
+
+// FIP - публичный IP, назначенный VM (white_ip)
+type FIP struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IPAddress string `json:"ip_address"`
+	Status    string `json:"status"`
+}
+
+// VMListResponse - ответ API со списком VM проекта
+type VMListResponse struct {
+	VMs           []VM   `json:"vms"`
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+// Flavor - допустимая конфигурация CPU/RAM с ценой
+type Flavor struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	CPU           int     `json:"cpu"`
+	Memory        string  `json:"memory"`
+	PricePerHour  float64 `json:"price_per_hour"`
+	PricePerMonth float64 `json:"price_per_month"`
+	Currency      string  `json:"currency"`
+}
+
+// FlavorListResponse - ответ API со списком flavors
+type FlavorListResponse struct {
+	Flavors       []Flavor `json:"flavors"`
+	NextPageToken string   `json:"next_page_token,omitempty"`
+}
+
+// RebuildVMRequest - DTO для переустановки ОС VM из другого образа
+type RebuildVMRequest struct {
+	Image    string `json:"image"`
+	UserData string `json:"user_data,omitempty"`
+}
+
+// BootDiskSpec - параметры boot диска при создании VM
+type BootDiskSpec struct {
+	Size             string `json:"size,omitempty"`
+	StorageClass     string `json:"storage_class,omitempty"`
+	Image            string `json:"image,omitempty"`
+	SourceSnapshotID string `json:"source_snapshot_id,omitempty"`
+}
+
+// DataDiskSpec - параметры дополнительного диска, создаваемого и подключаемого вместе с VM
+type DataDiskSpec struct {
+	Name         string `json:"name"`
+	Size         string `json:"size"`
+	StorageClass string `json:"storage_class"`
+}
+
+// VMDisk - диск VM в ответе API (boot или дополнительный)
+type VMDisk struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Size         string `json:"size"`
+	StorageClass string `json:"storage_class"`
+	Boot         bool   `json:"boot"`
+}
+
+// UpdateVMDiskRequest - DTO для увеличения диска VM
+type UpdateVMDiskRequest struct {
+	Size string `json:"size"`
+}
+
+// NetworkInterfaceSpec - параметры сетевого интерфейса VM
+type NetworkInterfaceSpec struct {
+	SubnetID         string   `json:"subnet_id"`
+	IPAddress        string   `json:"ip_address,omitempty"`
+	SecurityGroupIDs []string `json:"security_group_ids,omitempty"`
+}
+
+// VMInterface - сетевой интерфейс VM в ответе API
+type VMInterface struct {
+	ID               string   `json:"id"`
+	SubnetID         string   `json:"subnet_id"`
+	IPAddress        string   `json:"ip_address"`
+	MACAddress       string   `json:"mac_address"`
+	SecurityGroupIDs []string `json:"security_group_ids,omitempty"`
+	Primary          bool     `json:"primary"`
+}
+
+// UpdateVMInterfaceRequest - DTO для обновления security groups интерфейса
+type UpdateVMInterfaceRequest struct {
+	SecurityGroupIDs []string `json:"security_group_ids"`
+}
+
+// Network - подсеть OVN (GET /api/ovn/v1/networks), нужна для проверки адресов интерфейсов
+type Network struct {
+	SubnetID   string `json:"subnet_id"`
+	SubnetName string `json:"subnet_name"`
+	CIDRBlock  string `json:"cidr_block"`
+}
+
+// NetworkListResponse - страница списка подсетей проекта
+type NetworkListResponse struct {
+	Networks      []Network `json:"networks"`
+	NextPageToken string    `json:"next_page_token,omitempty"`
+}
+
+// PlacementGroup - группа размещения VM в ответе API
+type PlacementGroup struct {
+	ID        string            `json:"id"`
+	ProjectID string            `json:"project_id"`
+	Name      string            `json:"name"`
+	Strategy  string            `json:"strategy"`
+	VMIDs     []string          `json:"vm_ids,omitempty"`
+	Status    string            `json:"status"`
+	CreatedAt string            `json:"created_at"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// CreatePlacementGroupRequest - DTO для создания группы размещения
+type CreatePlacementGroupRequest struct {
+	ProjectID string            `json:"project_id"`
+	Name      string            `json:"name"`
+	Strategy  string            `json:"strategy"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// UpdatePlacementGroupRequest - DTO для обновления labels группы размещения
+type UpdatePlacementGroupRequest struct {
+	Labels map[string]string `json:"labels"`
+}
+
+// VMEvent - событие VM (смена статуса, ошибки загрузки образа, квоты, планировщика)
+type VMEvent struct {
+	Type      string `json:"type"`
+	Reason    string `json:"reason"`
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+}
+
+// VMEventListResponse - ответ API со списком событий VM
+type VMEventListResponse struct {
+	Events []VMEvent `json:"events"`
+}
+
+// ConsoleOutput - вывод serial console VM
+type ConsoleOutput struct {
+	Output string `json:"output"`
+}
+
+// CreateVMSnapshotRequest - DTO для создания согласованного снапшота всех дисков VM
+type CreateVMSnapshotRequest struct {
+	ProjectID     string            `json:"project_id"`
+	Name          string            `json:"name"`
+	Quiesce       bool              `json:"quiesce"`
+	UseGuestAgent bool              `json:"use_guest_agent"`
+	Labels        map[string]string `json:"labels,omitempty"`
+}
+
+// UpdateVMSnapshotRequest - DTO для обновления labels снапшота VM
+type UpdateVMSnapshotRequest struct {
+	Labels map[string]string `json:"labels"`
+}
+
+// VMSnapshot - снапшот VM в ответе API
+type VMSnapshot struct {
+	ID            string            `json:"id"`
+	ProjectID     string            `json:"project_id"`
+	VMID          string            `json:"vm_id"`
+	Name          string            `json:"name"`
+	Quiesced      bool              `json:"quiesced"`
+	GuestAgent    bool              `json:"guest_agent"`
+	DiskSnapshots []VMDiskSnapshot  `json:"disk_snapshots"`
+	Status        string            `json:"status"`
+	Message       string            `json:"message,omitempty"`
+	CreatedAt     string            `json:"created_at"`
+	Labels        map[string]string `json:"labels,omitempty"`
+}
+
+// VMDiskSnapshot - снапшот одного диска в составе снапшота VM
+type VMDiskSnapshot struct {
+	DiskID     string `json:"disk_id"`
+	DiskName   string `json:"disk_name"`
+	Boot       bool   `json:"boot"`
+	SnapshotID string `json:"snapshot_id"`
+	Size       string `json:"size"`
+}
+
+// VMTemplate - шаблон VM для групп (неизменяемые параметры создания VM)
+type VMTemplate struct {
+	ID        string            `json:"id"`
+	ProjectID string            `json:"project_id"`
+	Name      string            `json:"name"`
+	Spec      CreateVMRequest   `json:"spec"`
+	CreatedAt string            `json:"created_at"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// CreateVMTemplateRequest - DTO для создания шаблона VM
+type CreateVMTemplateRequest struct {
+	ProjectID string            `json:"project_id"`
+	Name      string            `json:"name"`
+	Spec      CreateVMRequest   `json:"spec"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// UpdateVMTemplateRequest - DTO для обновления labels шаблона VM
+type UpdateVMTemplateRequest struct {
+	Labels map[string]string `json:"labels"`
+}
+
+// VMGroup - группа одинаковых VM, созданных из шаблона
+type VMGroup struct {
+	ID         string            `json:"id"`
+	ProjectID  string            `json:"project_id"`
+	Name       string            `json:"name"`
+	TemplateID string            `json:"template_id"`
+	SubnetID   string            `json:"subnet_id"`
+	TargetSize int               `json:"target_size"`
+	Instances  []VMGroupInstance `json:"instances"`
+	// Autoscaled - к группе подключен автоскейлер, target_size меняет он
+	Autoscaled bool              `json:"autoscaled"`
+	Status     string            `json:"status"`
+	CreatedAt  string            `json:"created_at"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+// VMGroupInstance - VM в составе группы и шаблон, из которого она создана
+type VMGroupInstance struct {
+	VMID       string `json:"vm_id"`
+	TemplateID string `json:"template_id"`
+	Status     string `json:"status"`
+}
+
+// CreateVMGroupRequest - DTO для создания группы VM
+type CreateVMGroupRequest struct {
+	ProjectID  string            `json:"project_id"`
+	Name       string            `json:"name"`
+	TemplateID string            `json:"template_id"`
+	SubnetID   string            `json:"subnet_id"`
+	TargetSize int               `json:"target_size"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+// UpdateVMGroupRequest - DTO для обновления группы VM (nil - поле не меняется).
+// Новый template_id применяется только к VM, создаваемым после обновления
+type UpdateVMGroupRequest struct {
+	TemplateID *string            `json:"template_id,omitempty"`
+	TargetSize *int               `json:"target_size,omitempty"`
+	Labels     *map[string]string `json:"labels,omitempty"`
+}
+
+// AutoscalerSchedule - плановое изменение границ размера группы (например, на ночь)
+type AutoscalerSchedule struct {
+	Name     string `json:"name"`
+	Cron     string `json:"cron"`
+	TimeZone string `json:"time_zone,omitempty"`
+	MinSize  int    `json:"min_size"`
+	MaxSize  int    `json:"max_size"`
+}
+
+// VMAutoscaler - автоскейлер группы VM в ответе API
+type VMAutoscaler struct {
+	ID                   string               `json:"id"`
+	ProjectID            string               `json:"project_id"`
+	GroupID              string               `json:"group_id"`
+	MinSize              int                  `json:"min_size"`
+	MaxSize              int                  `json:"max_size"`
+	TargetCPUUtilization int                  `json:"target_cpu_utilization"`
+	CooldownSeconds      int                  `json:"cooldown_seconds"`
+	Schedules            []AutoscalerSchedule `json:"schedules,omitempty"`
+	CurrentSize          int                  `json:"current_size"`
+	Status               string               `json:"status"`
+	CreatedAt            string               `json:"created_at"`
+}
+
+// CreateVMAutoscalerRequest - DTO для подключения автоскейлера к группе VM
+type CreateVMAutoscalerRequest struct {
+	ProjectID            string               `json:"project_id"`
+	GroupID              string               `json:"group_id"`
+	MinSize              int                  `json:"min_size"`
+	MaxSize              int                  `json:"max_size"`
+	TargetCPUUtilization int                  `json:"target_cpu_utilization"`
+	CooldownSeconds      int                  `json:"cooldown_seconds"`
+	Schedules            []AutoscalerSchedule `json:"schedules,omitempty"`
+}
+
+// UpdateVMAutoscalerRequest - DTO для обновления политики автоскейлера (schedules заменяются целиком)
+type UpdateVMAutoscalerRequest struct {
+	MinSize              int                  `json:"min_size"`
+	MaxSize              int                  `json:"max_size"`
+	TargetCPUUtilization int                  `json:"target_cpu_utilization"`
+	CooldownSeconds      int                  `json:"cooldown_seconds"`
+	Schedules            []AutoscalerSchedule `json:"schedules"`
 }
@@ -0,0 +1,132 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// networkAttributes - computed атрибуты сетевого интерфейса VM для схемы ресурса
+func networkAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"private_ip": schema.StringAttribute{
+			MarkdownDescription: "Private IPv4 address of the primary interface",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv6_addresses": schema.ListAttribute{
+			MarkdownDescription: "IPv6 addresses of the primary interface",
+			ElementType:         types.StringType,
+			Computed:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"mac_address": schema.StringAttribute{
+			MarkdownDescription: "MAC address of the primary interface",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"subnet_id": schema.StringAttribute{
+			MarkdownDescription: "Subnet ID (`h3_ovn_network.subnet_id`) of the primary interface. Must belong to the VM project; mutually exclusive with subnet_name and network_interface. Computed when omitted",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+	}
+}
+
+// modifyPlanSubnet проверяет subnet_id: конфликты с другими способами задать сеть и проект подсети
+func (r *VMResource) modifyPlanSubnet(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config VMResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.SubnetID.IsNull() {
+		return
+	}
+
+	if !config.SubnetName.IsNull() || len(config.NetworkInterfaces.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnet_id"),
+			"Conflicting network settings",
+			"subnet_id is mutually exclusive with subnet_name and network_interface",
+		)
+		return
+	}
+
+	// subnet_id еще не известен (подсеть создается в этом же apply) - проверим при создании
+	if config.SubnetID.IsUnknown() || config.ProjectID.IsUnknown() || r.client == nil {
+		return
+	}
+
+	if _, err := getNetwork(ctx, r.client, config.SubnetID.ValueString(), config.ProjectID.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("subnet_id"), "Invalid subnet", err.Error())
+	}
+}
+
+// getNetwork ищет подсеть среди подсетей проекта VM (в ответе API подсети нет project_id,
+// поэтому принадлежность проекту определяется только по списку проекта)
+func getNetwork(ctx context.Context, c *client.Client, subnetID, projectID string) (*Network, error) {
+	queryParams := map[string]string{
+		"project_id": projectID,
+	}
+
+	for {
+		var listResp NetworkListResponse
+		if err := c.Do(ctx, "GET", "/api/ovn/v1/networks", queryParams, nil, &listResp); err != nil {
+			return nil, fmt.Errorf("could not list subnets of project %s: %w", projectID, err)
+		}
+		for _, network := range listResp.Networks {
+			if network.SubnetID == subnetID {
+				return &network, nil
+			}
+		}
+		if listResp.NextPageToken == "" {
+			break
+		}
+		queryParams["page_token"] = listResp.NextPageToken
+	}
+
+	// В проекте подсети нет - уточняем, существует ли она вообще
+	var network Network
+	if err := c.Do(ctx, "GET", "/api/ovn/v1/networks/"+subnetID, nil, nil, &network); err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			return nil, fmt.Errorf("subnet %s not found", subnetID)
+		}
+		return nil, fmt.Errorf("could not read subnet %s: %w", subnetID, err)
+	}
+	return nil, fmt.Errorf("subnet %s does not belong to the VM project %s", subnetID, projectID)
+}
+
+// setNetworkFromAPI заполняет сетевые атрибуты модели из ответа API
+func setNetworkFromAPI(model *VMResourceModel, vm VM) {
+	model.PrivateIP = types.StringValue(vm.PrivateIP)
+	model.IPv6Addresses = stringListValue(vm.IPv6Addresses)
+	model.MACAddress = types.StringValue(vm.MACAddress)
+	model.SubnetID = types.StringValue(vm.SubnetID)
+}
+
+// stringListValue конвертирует []string в types.List (nil дает пустой список)
+func stringListValue(values []string) types.List {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
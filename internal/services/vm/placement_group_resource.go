@@ -0,0 +1,281 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	placementStrategySpread  = "spread"
+	placementStrategyCluster = "cluster"
+)
+
+var (
+	_ resource.Resource                = &PlacementGroupResource{}
+	_ resource.ResourceWithConfigure   = &PlacementGroupResource{}
+	_ resource.ResourceWithImportState = &PlacementGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &PlacementGroupResource{}
+)
+
+// NewPlacementGroupResource создает новый ресурс placement group
+func NewPlacementGroupResource() resource.Resource {
+	return &PlacementGroupResource{}
+}
+
+// PlacementGroupResource - ресурс для управления группами размещения VM
+type PlacementGroupResource struct {
+	client *client.Client
+}
+
+// PlacementGroupResourceModel - модель состояния ресурса
+type PlacementGroupResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	Strategy  types.String `tfsdk:"strategy"`
+	VMIDs     types.List   `tfsdk:"vm_ids"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Labels    types.Map    `tfsdk:"labels"`
+	LabelsAll types.Map    `tfsdk:"labels_all"`
+}
+
+// Metadata возвращает метаданные ресурса
+func (r *PlacementGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_placement_group"
+}
+
+// Schema определяет схему ресурса
+func (r *PlacementGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages H3 Cloud VM placement group. VMs in a `spread` group run on different hypervisors, VMs in a `cluster` group are packed onto as few hypervisors as possible",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placement group ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID (UUID)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Placement group name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"strategy": schema.StringAttribute{
+				MarkdownDescription: "Placement strategy: `spread` (anti-affinity) or `cluster` (affinity)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the VMs in the group",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Placement group status",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels":     labels.Attribute(),
+			"labels_all": labels.AllAttribute(),
+		},
+	}
+}
+
+// Configure инициализирует ресурс с клиентом
+func (r *PlacementGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan вычисляет labels_all и проверяет strategy
+func (r *PlacementGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var strategy types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("strategy"), &strategy)...)
+	if strategy.IsUnknown() {
+		return
+	}
+	if s := strategy.ValueString(); s != placementStrategySpread && s != placementStrategyCluster {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("strategy"),
+			"Invalid placement strategy",
+			fmt.Sprintf("strategy must be %q or %q, got: %q", placementStrategySpread, placementStrategyCluster, s),
+		)
+	}
+}
+
+// Create создает новую placement group
+func (r *PlacementGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PlacementGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := CreatePlacementGroupRequest{
+		ProjectID: plan.ProjectID.ValueString(),
+		Name:      plan.Name.ValueString(),
+		Strategy:  plan.Strategy.ValueString(),
+		Labels:    allLabels,
+	}
+
+	var group PlacementGroup
+	if err := r.client.Do(ctx, "POST", "/api/vms/v1/placement-groups", nil, createReq, &group); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating placement group",
+			"Could not create placement group: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(group.ID)
+	plan.VMIDs = stringListValue(group.VMIDs)
+	plan.Status = types.StringValue(group.Status)
+	plan.CreatedAt = types.StringValue(group.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read читает текущее состояние placement group
+func (r *PlacementGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PlacementGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var group PlacementGroup
+	err := r.client.Do(ctx, "GET", "/api/vms/v1/placement-groups/"+state.ID.ValueString(), nil, nil, &group)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading placement group", err.Error())
+		return
+	}
+
+	state.ProjectID = types.StringValue(group.ProjectID)
+	state.Name = types.StringValue(group.Name)
+	state.Strategy = types.StringValue(group.Strategy)
+	state.VMIDs = stringListValue(group.VMIDs)
+	state.Status = types.StringValue(group.Status)
+	state.CreatedAt = types.StringValue(group.CreatedAt)
+
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, group.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+	state.LabelsAll = labels.Value(group.Labels)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update обновляет labels placement group (остальные атрибуты требуют замены)
+func (r *PlacementGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PlacementGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updateReq := UpdatePlacementGroupRequest{Labels: allLabels}
+		err := r.client.Do(ctx, "PATCH", "/api/vms/v1/placement-groups/"+state.ID.ValueString(), nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating placement group",
+				"Could not update placement group labels: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.VMIDs = state.VMIDs
+	plan.Status = state.Status
+	plan.CreatedAt = state.CreatedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete удаляет placement group (backend отказывает, пока в группе есть VM)
+func (r *PlacementGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PlacementGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Do(ctx, "DELETE", "/api/vms/v1/placement-groups/"+state.ID.ValueString(), nil, nil, nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting placement group", err.Error())
+	}
+}
+
+// ImportState импортирует placement group по ID
+func (r *PlacementGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,34 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// imageReplaceIf - пересоздание VM при смене образа, если не включен rebuild_on_image_change
+// (иначе VM переустанавливается in-place с сохранением ID, endpoint и EIP)
+func imageReplaceIf() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var rebuild types.Bool
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rebuild_on_image_change"), &rebuild)...)
+			resp.RequiresReplace = !rebuild.ValueBool()
+		},
+		"Changing the image replaces the VM unless rebuild_on_image_change is true.",
+		"Changing the image replaces the VM unless `rebuild_on_image_change` is `true`.",
+	)
+}
+
+// rebuildVM переустанавливает ОС VM из нового образа и ждет RUNNING
+func (r *VMResource) rebuildVM(ctx context.Context, vmID string, rebuildReq RebuildVMRequest, timeout time.Duration) error {
+	if err := r.client.Do(ctx, "POST", "/api/vms/v1/"+vmID+"/rebuild", nil, rebuildReq, nil); err != nil {
+		return fmt.Errorf("could not rebuild VM: %w", err)
+	}
+	return r.waitForVMReady(ctx, vmID, false, timeout)
+}
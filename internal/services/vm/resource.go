@@ -4,14 +4,21 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+	"h3terraform/internal/pkg/protection"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -20,6 +27,15 @@ var (
 	_ resource.Resource                = &VMResource{}
 	_ resource.ResourceWithConfigure   = &VMResource{}
 	_ resource.ResourceWithImportState = &VMResource{}
+	_ resource.ResourceWithModifyPlan  = &VMResource{}
+)
+
+// statusReasonPlacementFailed - причина ERROR, когда VM нельзя разместить по правилам placement group
+const statusReasonPlacementFailed = "PLACEMENT_FAILED"
+
+const (
+	powerStateRunning = "running"
+	powerStateStopped = "stopped"
 )
 
 // NewVMResource создает новый ресурс VM
@@ -34,21 +50,44 @@ type VMResource struct {
 
 // VMResourceModel - модель состояния ресурса
 type VMResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	ProjectID        types.String `tfsdk:"project_id"`
-	Name             types.String `tfsdk:"name"`
-	CPU              types.Int64  `tfsdk:"cpu"`
-	Memory           types.String `tfsdk:"memory"`
-	DiskSize         types.String `tfsdk:"disk_size"`
-	Image            types.String `tfsdk:"image"`
-	SSHKey           types.String `tfsdk:"ssh_key"`
-	SSHKeyID         types.String `tfsdk:"ssh_key_id"`
-	SubnetName       types.String `tfsdk:"subnet_name"`
-	WhiteIP          types.Bool   `tfsdk:"white_ip"`
-	SourceSnapshotID types.String `tfsdk:"source_snapshot_id"`
-	SourceBackupID   types.String `tfsdk:"source_backup_id"`
-	Status           types.String `tfsdk:"status"`
-	Endpoint         types.String `tfsdk:"endpoint"`
+	ID                      types.String `tfsdk:"id"`
+	ProjectID               types.String `tfsdk:"project_id"`
+	Name                    types.String `tfsdk:"name"`
+	Flavor                  types.String `tfsdk:"flavor"`
+	CPU                     types.Int64  `tfsdk:"cpu"`
+	Memory                  types.String `tfsdk:"memory"`
+	DiskSize                types.String `tfsdk:"disk_size"`
+	Image                   types.String `tfsdk:"image"`
+	RebuildOnImageChange    types.Bool   `tfsdk:"rebuild_on_image_change"`
+	SSHKey                  types.String `tfsdk:"ssh_key"`
+	SSHKeyID                types.String `tfsdk:"ssh_key_id"`
+	SubnetName              types.String `tfsdk:"subnet_name"`
+	WhiteIP                 types.Bool   `tfsdk:"white_ip"`
+	PublicIP                types.String `tfsdk:"public_ip"`
+	PrivateIP               types.String `tfsdk:"private_ip"`
+	IPv6Addresses           types.List   `tfsdk:"ipv6_addresses"`
+	MACAddress              types.String `tfsdk:"mac_address"`
+	SubnetID                types.String `tfsdk:"subnet_id"`
+	SourceSnapshotID        types.String `tfsdk:"source_snapshot_id"`
+	SourceBackupID          types.String `tfsdk:"source_backup_id"`
+	SourceDiskID            types.String `tfsdk:"source_disk_id"`
+	PlacementGroupID        types.String `tfsdk:"placement_group_id"`
+	CaptureConsoleOnFailure types.Bool   `tfsdk:"capture_console_on_failure"`
+	PreserveDiskOnDestroy   types.Bool   `tfsdk:"preserve_disk_on_destroy"`
+	BootDiskID              types.String `tfsdk:"boot_disk_id"`
+	BootDisk                types.Object `tfsdk:"boot_disk"`
+	DataDisks               types.List   `tfsdk:"data_disk"`
+	NetworkInterfaces       types.List   `tfsdk:"network_interface"`
+	UserData                types.String `tfsdk:"user_data"`
+	UserDataBase64          types.String `tfsdk:"user_data_base64"`
+	UserDataReplaceOnChange types.Bool   `tfsdk:"user_data_replace_on_change"`
+	Metadata                types.Map    `tfsdk:"metadata"`
+	PowerState              types.String `tfsdk:"power_state"`
+	Status                  types.String `tfsdk:"status"`
+	Endpoint                types.String `tfsdk:"endpoint"`
+	Labels                  types.Map    `tfsdk:"labels"`
+	LabelsAll               types.Map    `tfsdk:"labels_all"`
+	DeletionProtection      types.Bool   `tfsdk:"deletion_protection"`
 }
 
 // Metadata возвращает метаданные ресурса
@@ -83,23 +122,42 @@ func (r *VMResource) Schema(ctx context.Context, req resource.SchemaRequest, res
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"flavor": schema.StringAttribute{
+				MarkdownDescription: "VM flavor name or ID from the `h3_vm_flavors` data source (mutually exclusive with cpu and memory). Changing the flavor resizes the VM in place",
+				Optional:            true,
+			},
 			"cpu": schema.Int64Attribute{
-				MarkdownDescription: "Number of CPU cores",
-				Required:            true,
+				MarkdownDescription: "Number of CPU cores (required unless flavor is set)",
+				Optional:            true,
+				Computed:            true,
 			},
 			"memory": schema.StringAttribute{
-				MarkdownDescription: "Memory size (e.g., 4Gi, 2048Mi)",
-				Required:            true,
+				MarkdownDescription: "Memory size (e.g., 4Gi, 2048Mi; required unless flavor is set)",
+				Optional:            true,
+				Computed:            true,
 			},
 			"disk_size": schema.StringAttribute{
-				MarkdownDescription: "Disk size (e.g., 25Gi)",
+				MarkdownDescription: "Boot disk size (e.g., 25Gi); can be grown in place",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"image": schema.StringAttribute{
-				MarkdownDescription: "OS image (e.g., ubuntu:24.04)",
+				MarkdownDescription: "OS image name (e.g., ubuntu:24.04) or image ID, see the `h3_image` data source. Changing it replaces the VM, or rebuilds it in place with `rebuild_on_image_change`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					imageReplaceIf(),
+				},
+			},
+			"rebuild_on_image_change": schema.BoolAttribute{
+				MarkdownDescription: "Reinstall the OS in place when the image changes, keeping the VM ID, endpoint and public IP (default: false, which replaces the VM). Data on the boot disk is lost either way",
 				Optional:            true,
 				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"ssh_key": schema.StringAttribute{
 				MarkdownDescription: "SSH public key (mutually exclusive with ssh_key_id)",
@@ -117,16 +175,24 @@ func (r *VMResource) Schema(ctx context.Context, req resource.SchemaRequest, res
 				},
 			},
 			"subnet_name": schema.StringAttribute{
-				MarkdownDescription: "Subnet name (optional)",
+				MarkdownDescription: "Kubernetes subnet name (`h3_ovn_network.subnet_name`). Prefer subnet_id",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"white_ip": schema.BoolAttribute{
-				MarkdownDescription: "Enable public IP (default: false)",
+				MarkdownDescription: "Enable public IP (default: false). Can be toggled in place",
 				Optional:            true,
 				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"public_ip": schema.StringAttribute{
+				MarkdownDescription: "Public IP address assigned when `white_ip` is enabled",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"source_snapshot_id": schema.StringAttribute{
 				MarkdownDescription: "Create VM from snapshot (UUID)",
@@ -135,6 +201,19 @@ func (r *VMResource) Schema(ctx context.Context, req resource.SchemaRequest, res
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"capture_console_on_failure": schema.BoolAttribute{
+				MarkdownDescription: "Attach the last lines of the serial console output to the error when VM creation fails (default: false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"placement_group_id": schema.StringAttribute{
+				MarkdownDescription: "Placement group (`h3_placement_group`) to run the VM in. Changing it replaces the VM",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"source_backup_id": schema.StringAttribute{
 				MarkdownDescription: "Create VM from backup",
 				Optional:            true,
@@ -142,15 +221,74 @@ func (r *VMResource) Schema(ctx context.Context, req resource.SchemaRequest, res
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"source_disk_id": schema.StringAttribute{
+				MarkdownDescription: "Boot the VM from an existing disk, e.g. the preserved `boot_disk_id` of a destroyed VM",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preserve_disk_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Keep the boot disk when the VM is destroyed (default: false). The disk can then be imported as `h3_disk` using `boot_disk_id`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"boot_disk_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the boot disk created for the VM",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_data": schema.StringAttribute{
+				MarkdownDescription: "Cloud-init user data as plain text, up to 64 KiB (mutually exclusive with user_data_base64)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					userDataReplaceIf(),
+				},
+			},
+			"user_data_base64": schema.StringAttribute{
+				MarkdownDescription: "Cloud-init user data, base64-encoded (e.g. gzip output), up to 64 KiB decoded (mutually exclusive with user_data)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					userDataReplaceIf(),
+				},
+			},
+			"user_data_replace_on_change": schema.BoolAttribute{
+				MarkdownDescription: "Replace the VM when user data changes. When false (default), new user data is applied in place and picked up by the guest on the next reboot",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Key/value metadata exposed to the guest through the metadata service, updatable in place",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"power_state": schema.StringAttribute{
+				MarkdownDescription: "Desired power state: `running` or `stopped` (default: running). CPU and memory can be changed while the VM is stopped",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(powerStateRunning),
+			},
 			"status": schema.StringAttribute{
-				MarkdownDescription: "VM status (PENDING, RUNNING, etc.)",
+				MarkdownDescription: "VM status (PENDING, RUNNING, STOPPED, etc.)",
 				Computed:            true,
 			},
 			"endpoint": schema.StringAttribute{
 				MarkdownDescription: "VM endpoint/IP address",
 				Computed:            true,
 			},
+			"labels":              labels.Attribute(),
+			"labels_all":          labels.AllAttribute(),
+			"deletion_protection": protection.Attribute(),
 		},
+		Blocks: diskBlocks(),
+	}
+	resp.Schema.Blocks["network_interface"] = networkInterfaceBlock()
+	for name, attribute := range networkAttributes() {
+		resp.Schema.Attributes[name] = attribute
 	}
 }
 
@@ -172,6 +310,81 @@ func (r *VMResource) Configure(ctx context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
+// ModifyPlan добавляет default_labels провайдера в labels_all и раскрывает flavor в CPU/RAM
+func (r *VMResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.modifyPlanFlavor(ctx, req, resp)
+	r.modifyPlanDisks(ctx, req, resp)
+	r.modifyPlanInterfaces(ctx, req, resp)
+	r.modifyPlanSubnet(ctx, req, resp)
+	r.modifyPlanPublicIP(ctx, req, resp)
+
+	var userData, userDataBase64 types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("user_data"), &userData)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("user_data_base64"), &userDataBase64)...)
+	if !userData.IsUnknown() && !userDataBase64.IsUnknown() {
+		if _, err := userDataPayload(userData, userDataBase64); err != nil {
+			resp.Diagnostics.AddError("Invalid user data", err.Error())
+		}
+	}
+
+	var powerState types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("power_state"), &powerState)...)
+	if !powerState.IsUnknown() && powerState.ValueString() != powerStateRunning && powerState.ValueString() != powerStateStopped {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("power_state"),
+			"Invalid power_state",
+			fmt.Sprintf("power_state must be %q or %q, got: %q", powerStateRunning, powerStateStopped, powerState.ValueString()),
+		)
+	}
+}
+
+// modifyPlanFlavor проверяет, что задан либо flavor, либо cpu+memory,
+// и подставляет в план CPU/RAM выбранного flavor (ошибка видна уже на plan)
+func (r *VMResource) modifyPlanFlavor(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config VMResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Flavor.IsNull() {
+		if config.CPU.IsNull() || config.Memory.IsNull() {
+			resp.Diagnostics.AddError(
+				"Missing VM shape",
+				"Either flavor or both cpu and memory must be provided",
+			)
+		}
+		return
+	}
+
+	if !config.CPU.IsNull() || !config.Memory.IsNull() {
+		resp.Diagnostics.AddError(
+			"Conflicting VM shape fields",
+			"flavor is mutually exclusive with cpu and memory - provide only one",
+		)
+		return
+	}
+
+	// flavor еще не известен (зависит от другого ресурса) - CPU/RAM останутся unknown
+	if config.Flavor.IsUnknown() || r.client == nil {
+		return
+	}
+
+	flavor, err := findFlavor(ctx, r.client, config.Flavor.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("flavor"), "Invalid VM flavor", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cpu"), types.Int64Value(int64(flavor.CPU)))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("memory"), types.StringValue(flavor.Memory))...)
+}
+
 // Create создает новую VM
 func (r *VMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan VMResourceModel
@@ -216,25 +429,81 @@ func (r *VMResource) Create(ctx context.Context, req resource.CreateRequest, res
 		createReq.SSHKeyID = plan.SSHKeyID.ValueString()
 	}
 
-	if !plan.DiskSize.IsNull() {
+	if !plan.DiskSize.IsNull() && !plan.DiskSize.IsUnknown() {
 		createReq.DiskSize = plan.DiskSize.ValueString()
 	}
-	if !plan.Image.IsNull() {
+	if !plan.Image.IsNull() && !plan.Image.IsUnknown() {
 		createReq.Image = plan.Image.ValueString()
 	}
 	if !plan.SubnetName.IsNull() {
 		createReq.SubnetName = plan.SubnetName.ValueString()
 	}
+	// Backend принимает имя подсети в Kubernetes, поэтому subnet_id резолвим в subnet_name
+	if !plan.SubnetID.IsNull() && !plan.SubnetID.IsUnknown() {
+		network, err := getNetwork(ctx, r.client, plan.SubnetID.ValueString(), plan.ProjectID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("subnet_id"), "Invalid subnet", err.Error())
+			return
+		}
+		createReq.SubnetName = network.SubnetName
+	}
+	planNICs, diags := interfacesFromList(ctx, plan.NetworkInterfaces)
+	resp.Diagnostics.Append(diags...)
+	if len(planNICs) > 0 {
+		nics, diags := networkInterfaceSpecs(ctx, planNICs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createReq.NetworkInterfaces = nics
+	}
 	if !plan.SourceSnapshotID.IsNull() {
 		createReq.SourceSnapshotID = plan.SourceSnapshotID.ValueString()
 	}
 	if !plan.SourceBackupID.IsNull() {
 		createReq.SourceBackupID = plan.SourceBackupID.ValueString()
 	}
+	if !plan.SourceDiskID.IsNull() {
+		createReq.SourceDiskID = plan.SourceDiskID.ValueString()
+	}
+	if !plan.PlacementGroupID.IsNull() {
+		createReq.PlacementGroupID = plan.PlacementGroupID.ValueString()
+	}
+	bootDisk, diags := bootDiskFromObject(ctx, plan.BootDisk)
+	resp.Diagnostics.Append(diags...)
+	dataDisks, diags := dataDisksFromList(ctx, plan.DataDisks)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.BootDisk = bootDiskSpec(bootDisk)
+	createReq.DataDisks = dataDiskSpecs(dataDisks)
+
+	userData, err := userDataPayload(plan.UserData, plan.UserDataBase64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid user data", err.Error())
+		return
+	}
+	createReq.UserData = userData
+
+	metadata, diags := labels.ToMap(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Metadata = metadata
+
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Labels = allLabels
+	createReq.DeletionProtection = plan.DeletionProtection.ValueBool()
 
 	// Вызываем API (с HMAC подписью автоматически!)
 	var vm VM
-	err := r.client.Do(ctx, "POST", "/api/vms/v1", nil, createReq, &vm)
+	err = r.client.Do(ctx, "POST", "/api/vms/v1", nil, createReq, &vm)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating VM",
@@ -244,16 +513,44 @@ func (r *VMResource) Create(ctx context.Context, req resource.CreateRequest, res
 	}
 	log.Printf("[DEBUG] VM created, ID=%s, initial WhiteIP=%v (requested: %v)", vm.ID, vm.WhiteIP, createReq.WhiteIP)
 
-	// Ждем готовности VM (только RUNNING статус, не WhiteIP т.к. backend не обновляет это поле)
+	// Ждем готовности VM (только RUNNING статус, не WhiteIP т.к. backend не обновляет это поле -
+	// публичный IP проверяем ниже через FIP)
 	if err := r.waitForVMReady(ctx, vm.ID, false, 10*time.Minute); err != nil {
-		resp.Diagnostics.AddError(
-			"Error waiting for VM",
-			"VM created but not ready: "+err.Error(),
-		)
+		detail := "VM created but not ready: " + err.Error()
+		if plan.CaptureConsoleOnFailure.ValueBool() {
+			detail += consoleOnFailure(ctx, r.client, vm.ID)
+		}
+		resp.Diagnostics.AddError("Error waiting for VM", detail)
 		return
 	}
 	log.Printf("[DEBUG] VM %s is RUNNING, reading final state...", vm.ID)
 
+	// Data диски подключаются асинхронно и могут появиться в VM позже перехода в RUNNING
+	if len(dataDisks) > 0 {
+		if err := r.waitForVMDisks(ctx, vm.ID, dataDisks, 10*time.Minute); err != nil {
+			resp.Diagnostics.AddError("Error waiting for data disks", "VM created but data disks are not attached: "+err.Error())
+			return
+		}
+	}
+	// Дополнительные интерфейсы подключаются hot-plug после запуска VM
+	if len(planNICs) > 0 {
+		if err := r.waitForVMInterfaces(ctx, vm.ID, planNICs, 10*time.Minute); err != nil {
+			resp.Diagnostics.AddError("Error waiting for network interfaces", "VM created but network interfaces are not attached: "+err.Error())
+			return
+		}
+	}
+
+	// VM всегда создается запущенной, останавливаем если нужно
+	if plan.PowerState.ValueString() == powerStateStopped {
+		if err := r.setPowerState(ctx, vm.ID, powerStateStopped, 10*time.Minute); err != nil {
+			resp.Diagnostics.AddError(
+				"Error stopping VM",
+				"VM created but could not be stopped: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Читаем финальное состояние
 	if err := r.client.Do(ctx, "GET", "/api/vms/v1/"+vm.ID, nil, nil, &vm); err != nil {
 		resp.Diagnostics.AddError(
@@ -268,12 +565,33 @@ func (r *VMResource) Create(ctx context.Context, req resource.CreateRequest, res
 	plan.ID = types.StringValue(vm.ID)
 	plan.Status = types.StringValue(vm.Status)
 	plan.Endpoint = types.StringValue(vm.Endpoint)
+	plan.BootDiskID = types.StringValue(vm.BootDiskID)
+	plan.DiskSize = sizeValue(plan.DiskSize, vm.DiskSize)
+	if plan.Image.IsUnknown() {
+		plan.Image = types.StringValue(vm.Image)
+	}
+	if plan.CPU.IsUnknown() || plan.Memory.IsUnknown() {
+		plan.CPU = types.Int64Value(int64(vm.CPU))
+		plan.Memory = types.StringValue(vm.Memory)
+	}
+	resp.Diagnostics.Append(setDisksFromAPI(ctx, &plan, vm)...)
+	setNetworkFromAPI(&plan, vm)
+	resp.Diagnostics.Append(setInterfacesFromAPI(ctx, &plan, vm)...)
 
-	// backend не обновляет поле white_ip после создания FIP,
-	// поэтому сохраняем значение которое было запрошено пользователем
-	// (FIP создается корректно даже если white_ip в ответе null/false)
+	// backend не обновляет поле white_ip, поэтому ждем сам FIP и берем адрес из него
 	plan.WhiteIP = types.BoolValue(createReq.WhiteIP)
-	log.Printf("[DEBUG] Setting state: WhiteIP=%v (backend returned: %v)", createReq.WhiteIP, vm.WhiteIP)
+	plan.PublicIP = types.StringValue("")
+	if createReq.WhiteIP {
+		fip, err := r.waitForFIP(ctx, vm.ID, true, 10*time.Minute)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for public IP",
+				"VM created but public IP not assigned: "+err.Error(),
+			)
+			return
+		}
+		plan.PublicIP = types.StringValue(fip.IPAddress)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -298,20 +616,51 @@ func (r *VMResource) Read(ctx context.Context, req resource.ReadRequest, resp *r
 	}
 
 	// Debug logging
-	log.Printf("DEBUG Read VM: WhiteIP=%v (state has: %v)", vm.WhiteIP, state.WhiteIP.ValueBool())
+	log.Printf("DEBUG Read VM: Status=%s, Endpoint=%s", vm.Status, vm.Endpoint)
 
 	state.Status = types.StringValue(vm.Status)
 	state.Endpoint = types.StringValue(vm.Endpoint)
-	// Backend не обновляет white_ip, оставляем значение из state
-	// (если backend вернул white_ip=true, то обновим)
-	if vm.WhiteIP {
-		state.WhiteIP = types.BoolValue(true)
+	state.BootDiskID = types.StringValue(vm.BootDiskID)
+	if vm.PlacementGroupID != "" {
+		state.PlacementGroupID = types.StringValue(vm.PlacementGroupID)
+	}
+	resp.Diagnostics.Append(setDisksFromAPI(ctx, &state, vm)...)
+	setNetworkFromAPI(&state, vm)
+	resp.Diagnostics.Append(setInterfacesFromAPI(ctx, &state, vm)...)
+	if state.PreserveDiskOnDestroy.IsNull() {
+		state.PreserveDiskOnDestroy = types.BoolValue(false)
+	}
+	// Переходные статусы (STARTING, STOPPING и т.п.) не считаем дрифтом
+	if ps, ok := powerStateFromStatus(vm.Status); ok {
+		state.PowerState = types.StringValue(ps)
+	}
+	// Backend не обновляет white_ip, поэтому наличие публичного IP определяем по FIP
+	fip, err := getFIP(ctx, r.client, vm.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading VM", err.Error())
+		return
+	}
+	state.WhiteIP = types.BoolValue(fip != nil)
+	state.PublicIP = types.StringValue("")
+	if fip != nil {
+		state.PublicIP = types.StringValue(fip.IPAddress)
+	}
+
+	// user data API не возвращает, оставляем значение из state
+	if len(vm.Metadata) > 0 || !state.Metadata.IsNull() {
+		state.Metadata = labels.Value(vm.Metadata)
 	}
 
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, vm.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+	state.LabelsAll = labels.Value(vm.Labels)
+	state.DeletionProtection = protection.FromAPI(vm.DeletionProtection, state.DeletionProtection)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Update обновляет VM (пока не поддерживается)
+// Update обновляет VM (CPU/RAM/labels)
 func (r *VMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan VMResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -325,6 +674,22 @@ func (r *VMResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		return
 	}
 
+	planBootDisk, diags := bootDiskFromObject(ctx, plan.BootDisk)
+	resp.Diagnostics.Append(diags...)
+	stateBootDisk, diags := bootDiskFromObject(ctx, state.BootDisk)
+	resp.Diagnostics.Append(diags...)
+	planDataDisks, diags := dataDisksFromList(ctx, plan.DataDisks)
+	resp.Diagnostics.Append(diags...)
+	stateDataDisks, diags := dataDisksFromList(ctx, state.DataDisks)
+	resp.Diagnostics.Append(diags...)
+	planNICs, diags := interfacesFromList(ctx, plan.NetworkInterfaces)
+	resp.Diagnostics.Append(diags...)
+	stateNICs, diags := interfacesFromList(ctx, state.NetworkInterfaces)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Формируем запрос обновления
 	updateReq := UpdateVMRequest{}
 
@@ -340,34 +705,180 @@ func (r *VMResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		updateReq.Memory = &memory
 	}
 
+	// Проверяем, изменился ли размер boot диска (только увеличение, проверено в ModifyPlan)
+	planDiskSize, stateDiskSize := plan.DiskSize, state.DiskSize
+	if planBootDisk != nil && stateBootDisk != nil {
+		planDiskSize, stateDiskSize = planBootDisk.Size, stateBootDisk.Size
+	}
+	if !planDiskSize.IsUnknown() && !planDiskSize.IsNull() && !planDiskSize.Equal(stateDiskSize) {
+		diskSize := planDiskSize.ValueString()
+		updateReq.DiskSize = &diskSize
+	}
+
+	// Проверяем, изменилась ли user data (при user_data_replace_on_change сюда не попадаем - VM пересоздается)
+	if !plan.UserData.Equal(state.UserData) || !plan.UserDataBase64.Equal(state.UserDataBase64) {
+		userData, err := userDataPayload(plan.UserData, plan.UserDataBase64)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid user data", err.Error())
+			return
+		}
+		updateReq.UserData = &userData
+	}
+
+	// Проверяем, изменилась ли metadata
+	if !plan.Metadata.Equal(state.Metadata) {
+		metadata, diags := labels.ToMap(ctx, plan.Metadata)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if metadata == nil {
+			metadata = map[string]string{}
+		}
+		updateReq.Metadata = &metadata
+	}
+
+	// Проверяем, изменились ли labels
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Labels = &allLabels
+	}
+
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		protected := plan.DeletionProtection.ValueBool()
+		updateReq.DeletionProtection = &protected
+	}
+
+	// Смена flavor с тем же CPU/RAM, локальных флагов (user_data_replace_on_change и т.п.) или labels
+	// без изменения labels_all (ключ перенесен из default_labels) не требует запроса к API
+	localChanged := !plan.Flavor.Equal(state.Flavor) || !plan.Labels.Equal(state.Labels) || !plan.UserDataReplaceOnChange.Equal(state.UserDataReplaceOnChange) ||
+		!plan.PreserveDiskOnDestroy.Equal(state.PreserveDiskOnDestroy) || !plan.RebuildOnImageChange.Equal(state.RebuildOnImageChange) ||
+		!plan.CaptureConsoleOnFailure.Equal(state.CaptureConsoleOnFailure)
+
+	// Смена образа доходит до Update только при rebuild_on_image_change, иначе VM пересоздается
+	planImage, stateImage := plan.Image, state.Image
+	if planBootDisk != nil && stateBootDisk != nil {
+		planImage, stateImage = planBootDisk.Image, stateBootDisk.Image
+	}
+	rebuild := !planImage.IsUnknown() && !planImage.IsNull() && !planImage.Equal(stateImage)
+	powerChanged := !plan.PowerState.Equal(state.PowerState)
+	whiteIPChanged := !plan.WhiteIP.Equal(state.WhiteIP)
+	resized := updateReq.CPU != nil || updateReq.Memory != nil
+
 	// Если ничего не изменилось (только ForceNew поля), возвращаем ошибку
-	if updateReq.CPU == nil && updateReq.Memory == nil {
+	disksChanged := dataDisksChanged(planDataDisks, stateDataDisks)
+	nicsChanged := interfacesChanged(planNICs, stateNICs)
+	patched := resized || updateReq.DiskSize != nil || updateReq.UserData != nil || updateReq.Metadata != nil || updateReq.Labels != nil ||
+		updateReq.DeletionProtection != nil
+	if !patched && !localChanged && !powerChanged && !disksChanged && !rebuild && !whiteIPChanged && !nicsChanged {
 		resp.Diagnostics.AddError(
 			"Update not supported for these changes",
-			"Only CPU and memory can be updated in-place. Other changes require resource replacement.",
+			"Only flavor, CPU, memory, image (with rebuild_on_image_change), disk sizes, data disks, secondary network interfaces, power_state, white_ip, user data, metadata, labels, preserve_disk_on_destroy and deletion_protection can be updated in-place. Other changes require resource replacement.",
 		)
 		return
 	}
 
-	// Вызываем API для обновления
-	path := "/api/vms/v1/" + state.ID.ValueString()
-	err := r.client.Do(ctx, "PATCH", path, nil, updateReq, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating VM",
-			"Could not update VM: "+err.Error(),
-		)
-		return
+	// Переустанавливаем ОС до остальных изменений; после rebuild VM запущена
+	if rebuild {
+		userData, err := userDataPayload(plan.UserData, plan.UserDataBase64)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid user data", err.Error())
+			return
+		}
+		rebuildReq := RebuildVMRequest{Image: planImage.ValueString(), UserData: userData}
+		if err := r.rebuildVM(ctx, state.ID.ValueString(), rebuildReq, 20*time.Minute); err != nil {
+			resp.Diagnostics.AddError("Error rebuilding VM", err.Error())
+			return
+		}
+		if plan.PowerState.ValueString() == powerStateStopped {
+			if err := r.setPowerState(ctx, state.ID.ValueString(), powerStateStopped, 10*time.Minute); err != nil {
+				resp.Diagnostics.AddError("Error stopping VM", err.Error())
+				return
+			}
+		}
+		// VM уже в целевом power_state
+		state.PowerState = plan.PowerState
+		powerChanged = false
+	}
+
+	// Останавливаем до изменения размера, чтобы не перезапускать VM лишний раз
+	if powerChanged && plan.PowerState.ValueString() == powerStateStopped {
+		if err := r.setPowerState(ctx, state.ID.ValueString(), powerStateStopped, 10*time.Minute); err != nil {
+			resp.Diagnostics.AddError("Error stopping VM", err.Error())
+			return
+		}
+	}
+
+	if patched {
+		// Вызываем API для обновления
+		path := "/api/vms/v1/" + state.ID.ValueString()
+		err := r.client.Do(ctx, "PATCH", path, nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating VM",
+				"Could not update VM: "+err.Error(),
+			)
+			return
+		}
 	}
 
 	// Ждем пока обновление применится (VM может остановиться и запуститься)
-	// При Update не ждем WhiteIP, т.к. он не меняется
-	if err := r.waitForVMReady(ctx, state.ID.ValueString(), false, 10*time.Minute); err != nil {
-		resp.Diagnostics.AddError(
-			"Error waiting for VM update",
-			"VM update initiated but not completed: "+err.Error(),
-		)
-		return
+	// При Update не ждем WhiteIP - публичный IP переключается отдельно через FIP; смена labels не перезапускает VM.
+	// Остановленная VM после изменения размера остается в STOPPED: это и VM, остановленная выше
+	// по plan, и VM, которая была остановлена и будет запущена только после изменения размера
+	if resized {
+		var err error
+		if plan.PowerState.ValueString() == powerStateStopped || state.PowerState.ValueString() == powerStateStopped {
+			err = r.waitForVMStatus(ctx, state.ID.ValueString(), "STOPPED", 10*time.Minute)
+		} else {
+			err = r.waitForVMReady(ctx, state.ID.ValueString(), false, 10*time.Minute)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for VM update",
+				"VM update initiated but not completed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if powerChanged && plan.PowerState.ValueString() == powerStateRunning {
+		if err := r.setPowerState(ctx, state.ID.ValueString(), powerStateRunning, 10*time.Minute); err != nil {
+			resp.Diagnostics.AddError("Error starting VM", err.Error())
+			return
+		}
+	}
+
+	if whiteIPChanged {
+		fip, err := r.setWhiteIP(ctx, state.ID.ValueString(), plan.WhiteIP.ValueBool(), 10*time.Minute)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating public IP", err.Error())
+			return
+		}
+		plan.PublicIP = types.StringValue("")
+		if fip != nil {
+			plan.PublicIP = types.StringValue(fip.IPAddress)
+		}
+	} else {
+		plan.PublicIP = state.PublicIP
+	}
+
+	if disksChanged {
+		resp.Diagnostics.Append(r.applyDataDiskChanges(ctx, state.ID.ValueString(), planDataDisks, stateDataDisks)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if nicsChanged {
+		resp.Diagnostics.Append(r.applyInterfaceChanges(ctx, state.ID.ValueString(), planNICs, stateNICs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Читаем финальное состояние
@@ -386,26 +897,28 @@ func (r *VMResource) Update(ctx context.Context, req resource.UpdateRequest, res
 	plan.Name = state.Name
 	plan.CPU = types.Int64Value(int64(vm.CPU))
 	plan.Memory = types.StringValue(vm.Memory)
-	plan.DiskSize = state.DiskSize
-	plan.Image = state.Image
+	plan.DiskSize = sizeValue(plan.DiskSize, vm.DiskSize)
 	plan.SSHKey = state.SSHKey
 	plan.SubnetName = state.SubnetName
 	plan.SourceSnapshotID = state.SourceSnapshotID
 	plan.SourceBackupID = state.SourceBackupID
 	plan.Status = types.StringValue(vm.Status)
 	plan.Endpoint = types.StringValue(vm.Endpoint)
-	plan.WhiteIP = types.BoolValue(vm.WhiteIP)
+	plan.BootDiskID = types.StringValue(vm.BootDiskID)
 
 	// Обновляем state
 	plan.ID = state.ID
 	plan.ProjectID = state.ProjectID
 	plan.Name = state.Name
-	plan.DiskSize = state.DiskSize
-	plan.Image = state.Image
+	plan.DiskSize = sizeValue(plan.DiskSize, vm.DiskSize)
+	// После rebuild образ в плане уже новый
 	plan.SSHKey = state.SSHKey
 	plan.SubnetName = state.SubnetName
 	plan.SourceSnapshotID = state.SourceSnapshotID
 	plan.SourceBackupID = state.SourceBackupID
+	resp.Diagnostics.Append(setDisksFromAPI(ctx, &plan, vm)...)
+	setNetworkFromAPI(&plan, vm)
+	resp.Diagnostics.Append(setInterfacesFromAPI(ctx, &plan, vm)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -418,8 +931,14 @@ func (r *VMResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		return
 	}
 
+	resp.Diagnostics.Append(protection.CheckDelete(state.DeletionProtection, "VM", state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Boot диск удаляется вместе с VM, если не включен preserve_disk_on_destroy
 	queryParams := map[string]string{
-		"preserve_disk": "false",
+		"preserve_disk": strconv.FormatBool(state.PreserveDiskOnDestroy.ValueBool()),
 	}
 
 	err := r.client.Do(ctx, "DELETE", "/api/vms/v1/"+state.ID.ValueString(), queryParams, nil, nil)
@@ -438,6 +957,75 @@ func (r *VMResource) ImportState(ctx context.Context, req resource.ImportStateRe
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// setPowerState запускает или останавливает VM и ждет соответствующего статуса
+func (r *VMResource) setPowerState(ctx context.Context, vmID, powerState string, timeout time.Duration) error {
+	action, status := "start", "RUNNING"
+	if powerState == powerStateStopped {
+		action, status = "stop", "STOPPED"
+	}
+
+	if err := r.client.Do(ctx, "POST", "/api/vms/v1/"+vmID+"/"+action, nil, nil, nil); err != nil {
+		return fmt.Errorf("could not %s VM: %w", action, err)
+	}
+	return r.waitForVMStatus(ctx, vmID, status, timeout)
+}
+
+// waitForVMStatus ждет пока VM перейдет в указанный статус
+func (r *VMResource) waitForVMStatus(ctx context.Context, vmID, status string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for VM to become %s", status)
+		case <-ticker.C:
+			var vm VM
+			if err := r.client.Do(ctx, "GET", "/api/vms/v1/"+vmID, nil, nil, &vm); err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] waitForVMStatus: VM %s Status=%s (want %s)", vmID, vm.Status, status)
+
+			if vm.Status == "ERROR" {
+				return r.vmFailure(ctx, vm)
+			}
+			if vm.Status == status {
+				return nil
+			}
+		}
+	}
+}
+
+// vmError формирует ошибку для VM в статусе ERROR с причиной от backend
+func vmError(vm VM) error {
+	if vm.StatusReason == statusReasonPlacementFailed {
+		msg := fmt.Sprintf("VM could not be placed in placement group %s", vm.PlacementGroupID)
+		if vm.StatusMessage != "" {
+			msg += ": " + vm.StatusMessage
+		}
+		return fmt.Errorf("%s. A spread group needs a separate hypervisor for every VM; reduce the number of VMs in the group or use another group", msg)
+	}
+	if vm.StatusMessage == "" {
+		return fmt.Errorf("VM entered ERROR state")
+	}
+	return fmt.Errorf("VM entered ERROR state: %s", vm.StatusMessage)
+}
+
+// powerStateFromStatus возвращает power_state для стабильных статусов VM
+func powerStateFromStatus(status string) (string, bool) {
+	switch strings.ToUpper(status) {
+	case "RUNNING":
+		return powerStateRunning, true
+	case "STOPPED":
+		return powerStateStopped, true
+	}
+	return "", false
+}
+
 // waitForVMReady ждет пока VM станет RUNNING и опционально пока назначится WhiteIP
 func (r *VMResource) waitForVMReady(ctx context.Context, vmID string, waitForWhiteIP bool, timeout time.Duration) error {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
@@ -459,7 +1047,7 @@ func (r *VMResource) waitForVMReady(ctx context.Context, vmID string, waitForWhi
 			log.Printf("[DEBUG] waitForVMReady: VM %s Status=%s, WhiteIP=%v, Endpoint=%s", vmID, vm.Status, vm.WhiteIP, vm.Endpoint)
 
 			if vm.Status == "ERROR" {
-				return fmt.Errorf("VM entered ERROR state")
+				return r.vmFailure(ctx, vm)
 			}
 
 			if vm.Status == "RUNNING" {
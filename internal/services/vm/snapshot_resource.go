@@ -0,0 +1,425 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &VMSnapshotResource{}
+	_ resource.ResourceWithConfigure   = &VMSnapshotResource{}
+	_ resource.ResourceWithImportState = &VMSnapshotResource{}
+	_ resource.ResourceWithModifyPlan  = &VMSnapshotResource{}
+)
+
+// diskSnapshotAttrTypes - типы атрибутов элемента disk_snapshots
+var diskSnapshotAttrTypes = map[string]attr.Type{
+	"disk_id":     types.StringType,
+	"disk_name":   types.StringType,
+	"boot":        types.BoolType,
+	"snapshot_id": types.StringType,
+	"size":        types.StringType,
+}
+
+// NewVMSnapshotResource создает новый ресурс снапшота VM
+func NewVMSnapshotResource() resource.Resource {
+	return &VMSnapshotResource{}
+}
+
+// VMSnapshotResource - согласованный снапшот всех дисков VM
+type VMSnapshotResource struct {
+	client *client.Client
+}
+
+// VMSnapshotResourceModel - модель состояния ресурса
+type VMSnapshotResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	VMID               types.String `tfsdk:"vm_id"`
+	Name               types.String `tfsdk:"name"`
+	Quiesce            types.Bool   `tfsdk:"quiesce"`
+	UseGuestAgent      types.Bool   `tfsdk:"use_guest_agent"`
+	DiskSnapshots      types.List   `tfsdk:"disk_snapshots"`
+	BootDiskSnapshotID types.String `tfsdk:"boot_disk_snapshot_id"`
+	Status             types.String `tfsdk:"status"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Labels             types.Map    `tfsdk:"labels"`
+	LabelsAll          types.Map    `tfsdk:"labels_all"`
+}
+
+// Metadata возвращает метаданные ресурса
+func (r *VMSnapshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_snapshot"
+}
+
+// Schema определяет схему ресурса
+func (r *VMSnapshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Crash- or application-consistent snapshot of every disk attached to a VM, taken atomically",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "VM snapshot ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID (UUID)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the VM to snapshot",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Snapshot name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quiesce": schema.BoolAttribute{
+				MarkdownDescription: "Briefly pause disk I/O so all disks are captured at the same point in time (default: true)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"use_guest_agent": schema.BoolAttribute{
+				MarkdownDescription: "Freeze filesystems through the guest agent for an application-consistent snapshot; requires `quiesce` (default: false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"disk_snapshots": schema.ListNestedAttribute{
+				MarkdownDescription: "Per-disk snapshots. `snapshot_id` can be used as `h3_vm.source_snapshot_id` or `h3_disk.source_snapshot_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"disk_id": schema.StringAttribute{
+							MarkdownDescription: "Source disk ID",
+							Computed:            true,
+						},
+						"disk_name": schema.StringAttribute{
+							MarkdownDescription: "Source disk name",
+							Computed:            true,
+						},
+						"boot": schema.BoolAttribute{
+							MarkdownDescription: "Whether the source disk is the boot disk",
+							Computed:            true,
+						},
+						"snapshot_id": schema.StringAttribute{
+							MarkdownDescription: "Disk snapshot ID",
+							Computed:            true,
+						},
+						"size": schema.StringAttribute{
+							MarkdownDescription: "Snapshot size",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"boot_disk_snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "Snapshot ID of the boot disk, usable as `h3_vm.source_snapshot_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "VM snapshot status",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels":     labels.Attribute(),
+			"labels_all": labels.AllAttribute(),
+		},
+	}
+}
+
+// Configure инициализирует ресурс с клиентом
+func (r *VMSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan вычисляет labels_all и проверяет use_guest_agent
+func (r *VMSnapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var quiesce, useGuestAgent types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("quiesce"), &quiesce)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("use_guest_agent"), &useGuestAgent)...)
+	if useGuestAgent.ValueBool() && !quiesce.IsUnknown() && !quiesce.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("use_guest_agent"),
+			"Conflicting snapshot settings",
+			"use_guest_agent requires quiesce = true",
+		)
+	}
+}
+
+// Create создает снапшот всех дисков VM и ждет его готовности
+func (r *VMSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VMSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := CreateVMSnapshotRequest{
+		ProjectID:     plan.ProjectID.ValueString(),
+		Name:          plan.Name.ValueString(),
+		Quiesce:       plan.Quiesce.ValueBool(),
+		UseGuestAgent: plan.UseGuestAgent.ValueBool(),
+		Labels:        allLabels,
+	}
+
+	var snapshot VMSnapshot
+	err := r.client.Do(ctx, "POST", "/api/vms/v1/"+plan.VMID.ValueString()+"/snapshots", nil, createReq, &snapshot)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating VM snapshot",
+			"Could not create VM snapshot: "+err.Error(),
+		)
+		return
+	}
+
+	// Сохраняем ID сразу, чтобы снапшот не потерялся, если ожидание упадет
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(snapshot.ID))...)
+
+	snapshot, err = r.waitForVMSnapshot(ctx, snapshot.ID, 30*time.Minute)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for VM snapshot",
+			"VM snapshot created but not ready: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(snapshot.ID)
+	resp.Diagnostics.Append(setVMSnapshotFromAPI(&plan, snapshot)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read читает текущее состояние снапшота VM
+func (r *VMSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VMSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var snapshot VMSnapshot
+	err := r.client.Do(ctx, "GET", "/api/vms/v1/snapshots/"+state.ID.ValueString(), nil, nil, &snapshot)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading VM snapshot", err.Error())
+		return
+	}
+
+	state.ProjectID = types.StringValue(snapshot.ProjectID)
+	state.VMID = types.StringValue(snapshot.VMID)
+	state.Name = types.StringValue(snapshot.Name)
+	// Параметры создания берем из API только при импорте
+	if state.Quiesce.IsNull() {
+		state.Quiesce = types.BoolValue(snapshot.Quiesced)
+	}
+	if state.UseGuestAgent.IsNull() {
+		state.UseGuestAgent = types.BoolValue(snapshot.GuestAgent)
+	}
+	resp.Diagnostics.Append(setVMSnapshotFromAPI(&state, snapshot)...)
+
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, snapshot.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update обновляет labels снапшота VM (остальные атрибуты требуют замены)
+func (r *VMSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VMSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updateReq := UpdateVMSnapshotRequest{Labels: allLabels}
+		err := r.client.Do(ctx, "PATCH", "/api/vms/v1/snapshots/"+state.ID.ValueString(), nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating VM snapshot",
+				"Could not update VM snapshot labels: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.DiskSnapshots = state.DiskSnapshots
+	plan.BootDiskSnapshotID = state.BootDiskSnapshotID
+	plan.Status = state.Status
+	plan.CreatedAt = state.CreatedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete удаляет снапшот VM вместе со снапшотами дисков
+func (r *VMSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VMSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Do(ctx, "DELETE", "/api/vms/v1/snapshots/"+state.ID.ValueString(), nil, nil, nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting VM snapshot", err.Error())
+	}
+}
+
+// ImportState импортирует снапшот VM по ID
+func (r *VMSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForVMSnapshot ждет, пока снапшоты всех дисков будут готовы
+func (r *VMSnapshotResource) waitForVMSnapshot(ctx context.Context, id string, timeout time.Duration) (VMSnapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return VMSnapshot{}, fmt.Errorf("timeout waiting for VM snapshot %s", id)
+		case <-ticker.C:
+			var snapshot VMSnapshot
+			if err := r.client.Do(ctx, "GET", "/api/vms/v1/snapshots/"+id, nil, nil, &snapshot); err != nil {
+				return VMSnapshot{}, err
+			}
+
+			log.Printf("[DEBUG] waitForVMSnapshot: %s Status=%s", id, snapshot.Status)
+
+			switch snapshot.Status {
+			case "READY", "AVAILABLE":
+				return snapshot, nil
+			case "FAILED", "ERROR":
+				if snapshot.Message != "" {
+					return VMSnapshot{}, fmt.Errorf("VM snapshot failed: %s", snapshot.Message)
+				}
+				return VMSnapshot{}, fmt.Errorf("VM snapshot failed")
+			}
+		}
+	}
+}
+
+// setVMSnapshotFromAPI заполняет computed атрибуты снапшота VM из ответа API
+func setVMSnapshotFromAPI(model *VMSnapshotResourceModel, snapshot VMSnapshot) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elems := make([]attr.Value, 0, len(snapshot.DiskSnapshots))
+	bootSnapshotID := ""
+	for _, ds := range snapshot.DiskSnapshots {
+		if ds.Boot {
+			bootSnapshotID = ds.SnapshotID
+		}
+		obj, d := types.ObjectValue(diskSnapshotAttrTypes, map[string]attr.Value{
+			"disk_id":     types.StringValue(ds.DiskID),
+			"disk_name":   types.StringValue(ds.DiskName),
+			"boot":        types.BoolValue(ds.Boot),
+			"snapshot_id": types.StringValue(ds.SnapshotID),
+			"size":        types.StringValue(ds.Size),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: diskSnapshotAttrTypes}, elems)
+	diags.Append(d...)
+
+	model.DiskSnapshots = list
+	model.BootDiskSnapshotID = types.StringValue(bootSnapshotID)
+	model.Status = types.StringValue(snapshot.Status)
+	model.CreatedAt = types.StringValue(snapshot.CreatedAt)
+	model.LabelsAll = labels.Value(snapshot.Labels)
+	return diags
+}
@@ -0,0 +1,490 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/labels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &VMTemplateResource{}
+	_ resource.ResourceWithConfigure   = &VMTemplateResource{}
+	_ resource.ResourceWithImportState = &VMTemplateResource{}
+	_ resource.ResourceWithModifyPlan  = &VMTemplateResource{}
+)
+
+// NewVMTemplateResource создает новый ресурс шаблона VM
+func NewVMTemplateResource() resource.Resource {
+	return &VMTemplateResource{}
+}
+
+// VMTemplateResource - неизменяемый шаблон VM для h3_vm_group
+type VMTemplateResource struct {
+	client *client.Client
+}
+
+// VMTemplateResourceModel - модель состояния ресурса
+type VMTemplateResourceModel struct {
+	ID               types.String              `tfsdk:"id"`
+	ProjectID        types.String              `tfsdk:"project_id"`
+	Name             types.String              `tfsdk:"name"`
+	CPU              types.Int64               `tfsdk:"cpu"`
+	Memory           types.String              `tfsdk:"memory"`
+	DiskSize         types.String              `tfsdk:"disk_size"`
+	Image            types.String              `tfsdk:"image"`
+	SSHKey           types.String              `tfsdk:"ssh_key"`
+	SSHKeyID         types.String              `tfsdk:"ssh_key_id"`
+	WhiteIP          types.Bool                `tfsdk:"white_ip"`
+	SourceSnapshotID types.String              `tfsdk:"source_snapshot_id"`
+	PlacementGroupID types.String              `tfsdk:"placement_group_id"`
+	UserData         types.String              `tfsdk:"user_data"`
+	UserDataBase64   types.String              `tfsdk:"user_data_base64"`
+	Metadata         types.Map                 `tfsdk:"metadata"`
+	BootDisk         *VMTemplateBootDiskModel  `tfsdk:"boot_disk"`
+	DataDisks        []VMTemplateDataDiskModel `tfsdk:"data_disk"`
+	CreatedAt        types.String              `tfsdk:"created_at"`
+	Labels           types.Map                 `tfsdk:"labels"`
+	LabelsAll        types.Map                 `tfsdk:"labels_all"`
+}
+
+// VMTemplateBootDiskModel - модель блока boot_disk шаблона
+type VMTemplateBootDiskModel struct {
+	Size         types.String `tfsdk:"size"`
+	StorageClass types.String `tfsdk:"storage_class"`
+	Image        types.String `tfsdk:"image"`
+}
+
+// VMTemplateDataDiskModel - модель блока data_disk шаблона
+type VMTemplateDataDiskModel struct {
+	Name         types.String `tfsdk:"name"`
+	Size         types.String `tfsdk:"size"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+// Metadata возвращает метаданные ресурса
+func (r *VMTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_template"
+}
+
+// Schema определяет схему ресурса
+func (r *VMTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Шаблон неизменяемый: любое изменение параметров VM создает новый шаблон
+	replaceString := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Immutable VM template used by `h3_vm_group`. Any change to the VM settings creates a new template; use `create_before_destroy` so groups can roll over to it",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Template ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID (UUID)",
+				Required:            true,
+				PlanModifiers:       replaceString,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Template name",
+				Required:            true,
+				PlanModifiers:       replaceString,
+			},
+			"cpu": schema.Int64Attribute{
+				MarkdownDescription: "Number of CPU cores",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"memory": schema.StringAttribute{
+				MarkdownDescription: "Memory size (e.g., 4Gi, 2048Mi)",
+				Required:            true,
+				PlanModifiers:       replaceString,
+			},
+			"disk_size": schema.StringAttribute{
+				MarkdownDescription: "Boot disk size (e.g., 25Gi)",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"image": schema.StringAttribute{
+				MarkdownDescription: "OS image name (e.g., ubuntu:24.04) or image ID, including `h3_image` IDs",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"ssh_key": schema.StringAttribute{
+				MarkdownDescription: "SSH public key (mutually exclusive with ssh_key_id)",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers:       replaceString,
+			},
+			"ssh_key_id": schema.StringAttribute{
+				MarkdownDescription: "SSH key ID from h3ssh service (mutually exclusive with ssh_key)",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"white_ip": schema.BoolAttribute{
+				MarkdownDescription: "Enable a public IP on every VM (default: false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "Create every VM from this snapshot (UUID)",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"placement_group_id": schema.StringAttribute{
+				MarkdownDescription: "Placement group (`h3_placement_group`) to run the VMs in",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"user_data": schema.StringAttribute{
+				MarkdownDescription: "Cloud-init user data as plain text, up to 64 KiB (mutually exclusive with user_data_base64)",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"user_data_base64": schema.StringAttribute{
+				MarkdownDescription: "Cloud-init user data, base64-encoded, up to 64 KiB decoded (mutually exclusive with user_data)",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Key/value metadata exposed to the guest through the metadata service",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels":     labels.Attribute(),
+			"labels_all": labels.AllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"boot_disk": schema.SingleNestedBlock{
+				MarkdownDescription: "Boot disk settings (alternative to disk_size/image)",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"size": schema.StringAttribute{
+						MarkdownDescription: "Boot disk size (e.g., 25Gi)",
+						Optional:            true,
+					},
+					"storage_class": schema.StringAttribute{
+						MarkdownDescription: "Storage class (e.g., 'replicated')",
+						Optional:            true,
+					},
+					"image": schema.StringAttribute{
+						MarkdownDescription: "OS image name or ID",
+						Optional:            true,
+					},
+				},
+			},
+			"data_disk": schema.ListNestedBlock{
+				MarkdownDescription: "Additional disks created and attached to every VM",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Disk name (unique within the VM)",
+							Required:            true,
+						},
+						"size": schema.StringAttribute{
+							MarkdownDescription: "Disk size (e.g., 100Gi)",
+							Required:            true,
+						},
+						"storage_class": schema.StringAttribute{
+							MarkdownDescription: "Storage class (e.g., 'replicated')",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure инициализирует ресурс с клиентом
+func (r *VMTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan вычисляет labels_all и проверяет взаимоисключающие параметры
+func (r *VMTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	labels.ModifyPlan(ctx, r.client.DefaultLabels(), req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan VMTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SSHKey.IsNull() && !plan.SSHKeyID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Conflicting SSH key settings",
+			"ssh_key and ssh_key_id are mutually exclusive - provide only one",
+		)
+	}
+
+	if !plan.UserData.IsUnknown() && !plan.UserDataBase64.IsUnknown() {
+		if _, err := userDataPayload(plan.UserData, plan.UserDataBase64); err != nil {
+			resp.Diagnostics.AddError("Invalid user data", err.Error())
+		}
+	}
+}
+
+// Create создает шаблон VM
+func (r *VMTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VMTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userData, err := userDataPayload(plan.UserData, plan.UserDataBase64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid user data", err.Error())
+		return
+	}
+
+	allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	metadata, diags := labels.ToMap(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec := CreateVMRequest{
+		ProjectID:        plan.ProjectID.ValueString(),
+		CPU:              int(plan.CPU.ValueInt64()),
+		Memory:           plan.Memory.ValueString(),
+		DiskSize:         plan.DiskSize.ValueString(),
+		Image:            plan.Image.ValueString(),
+		SSHKey:           plan.SSHKey.ValueString(),
+		SSHKeyID:         plan.SSHKeyID.ValueString(),
+		WhiteIP:          plan.WhiteIP.ValueBool(),
+		SourceSnapshotID: plan.SourceSnapshotID.ValueString(),
+		PlacementGroupID: plan.PlacementGroupID.ValueString(),
+		UserData:         userData,
+		Metadata:         metadata,
+		Labels:           allLabels,
+	}
+	if plan.BootDisk != nil {
+		spec.BootDisk = &BootDiskSpec{
+			Size:         plan.BootDisk.Size.ValueString(),
+			StorageClass: plan.BootDisk.StorageClass.ValueString(),
+			Image:        plan.BootDisk.Image.ValueString(),
+		}
+	}
+	for _, d := range plan.DataDisks {
+		spec.DataDisks = append(spec.DataDisks, DataDiskSpec{
+			Name:         d.Name.ValueString(),
+			Size:         d.Size.ValueString(),
+			StorageClass: d.StorageClass.ValueString(),
+		})
+	}
+
+	createReq := CreateVMTemplateRequest{
+		ProjectID: plan.ProjectID.ValueString(),
+		Name:      plan.Name.ValueString(),
+		Spec:      spec,
+		Labels:    allLabels,
+	}
+
+	var template VMTemplate
+	err = r.client.Do(ctx, "POST", "/api/vms/v1/templates", nil, createReq, &template)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating VM template",
+			"Could not create VM template: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(template.ID)
+	plan.CreatedAt = types.StringValue(template.CreatedAt)
+	plan.LabelsAll = labels.Value(template.Labels)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read читает текущее состояние шаблона VM
+func (r *VMTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VMTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var template VMTemplate
+	err := r.client.Do(ctx, "GET", "/api/vms/v1/templates/"+state.ID.ValueString(), nil, nil, &template)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading VM template", err.Error())
+		return
+	}
+
+	state.ProjectID = types.StringValue(template.ProjectID)
+	state.Name = types.StringValue(template.Name)
+	state.CreatedAt = types.StringValue(template.CreatedAt)
+	// Шаблон неизменяемый, параметры VM берем из API только при импорте
+	if state.CPU.IsNull() {
+		setVMTemplateSpecFromAPI(&state, template.Spec)
+	}
+	state.LabelsAll = labels.Value(template.Labels)
+
+	var diags diag.Diagnostics
+	state.Labels, diags = labels.FromAPI(ctx, template.Labels, r.client.DefaultLabels(), state.Labels)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update обновляет labels шаблона VM (остальные атрибуты требуют замены)
+func (r *VMTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VMTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.LabelsAll.Equal(state.LabelsAll) {
+		allLabels, diags := labels.ToMap(ctx, plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updateReq := UpdateVMTemplateRequest{Labels: allLabels}
+		err := r.client.Do(ctx, "PATCH", "/api/vms/v1/templates/"+state.ID.ValueString(), nil, updateReq, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating VM template",
+				"Could not update VM template labels: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt = state.CreatedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete удаляет шаблон VM (backend не дает удалить шаблон, который используют группы)
+func (r *VMTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VMTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Do(ctx, "DELETE", "/api/vms/v1/templates/"+state.ID.ValueString(), nil, nil, nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting VM template", err.Error())
+	}
+}
+
+// ImportState импортирует шаблон VM по ID
+func (r *VMTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setVMTemplateSpecFromAPI заполняет параметры VM шаблона из ответа API
+// (ssh_key и user data не возвращаются backend и остаются null)
+func setVMTemplateSpecFromAPI(model *VMTemplateResourceModel, spec CreateVMRequest) {
+	optional := func(v string) types.String {
+		if v == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(v)
+	}
+
+	model.CPU = types.Int64Value(int64(spec.CPU))
+	model.Memory = types.StringValue(spec.Memory)
+	model.DiskSize = optional(spec.DiskSize)
+	model.Image = optional(spec.Image)
+	model.SSHKeyID = optional(spec.SSHKeyID)
+	model.WhiteIP = types.BoolValue(spec.WhiteIP)
+	model.SourceSnapshotID = optional(spec.SourceSnapshotID)
+	model.PlacementGroupID = optional(spec.PlacementGroupID)
+	if len(spec.Metadata) > 0 {
+		model.Metadata = labels.Value(spec.Metadata)
+	} else {
+		model.Metadata = types.MapNull(types.StringType)
+	}
+
+	if spec.BootDisk != nil {
+		model.BootDisk = &VMTemplateBootDiskModel{
+			Size:         optional(spec.BootDisk.Size),
+			StorageClass: optional(spec.BootDisk.StorageClass),
+			Image:        optional(spec.BootDisk.Image),
+		}
+	}
+	model.DataDisks = nil
+	for _, d := range spec.DataDisks {
+		model.DataDisks = append(model.DataDisks, VMTemplateDataDiskModel{
+			Name:         types.StringValue(d.Name),
+			Size:         types.StringValue(d.Size),
+			StorageClass: types.StringValue(d.StorageClass),
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package vm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxUserDataSize - максимальный размер user data (до base64 кодирования)
+const maxUserDataSize = 64 * 1024
+
+// userDataPayload возвращает user data в base64 для API (пустая строка, если user data не задана)
+func userDataPayload(userData, userDataBase64 types.String) (string, error) {
+	var raw []byte
+	switch {
+	case !userData.IsNull() && !userDataBase64.IsNull():
+		return "", fmt.Errorf("user_data and user_data_base64 are mutually exclusive - provide only one")
+	case !userData.IsNull():
+		raw = []byte(userData.ValueString())
+	case !userDataBase64.IsNull():
+		decoded, err := base64.StdEncoding.DecodeString(userDataBase64.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("user_data_base64 is not valid base64: %w", err)
+		}
+		raw = decoded
+	default:
+		return "", nil
+	}
+
+	if len(raw) > maxUserDataSize {
+		return "", fmt.Errorf("user data is %d bytes, the maximum is %d bytes", len(raw), maxUserDataSize)
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// userDataReplaceIf - пересоздание VM при смене user data, если включен user_data_replace_on_change
+// (иначе новая user data применяется in-place и подхватывается гостем при следующей перезагрузке)
+func userDataReplaceIf() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var replaceOnChange types.Bool
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("user_data_replace_on_change"), &replaceOnChange)...)
+			resp.RequiresReplace = replaceOnChange.ValueBool()
+		},
+		"Changing user data replaces the VM when user_data_replace_on_change is true.",
+		"Changing user data replaces the VM when `user_data_replace_on_change` is `true`.",
+	)
+}
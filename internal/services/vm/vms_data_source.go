@@ -0,0 +1,130 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"h3terraform/internal/client"
+	"h3terraform/internal/pkg/filter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &VMsDataSource{}
+	_ datasource.DataSourceWithConfigure = &VMsDataSource{}
+)
+
+// NewVMsDataSource создает новый data source списка VM
+func NewVMsDataSource() datasource.DataSource {
+	return &VMsDataSource{}
+}
+
+// VMsDataSource - data source для получения списка VM проекта с фильтрацией
+type VMsDataSource struct {
+	client *client.Client
+}
+
+// VMsDataSourceModel - модель состояния data source
+type VMsDataSourceModel struct {
+	ProjectID types.String        `tfsdk:"project_id"`
+	NameRegex types.String        `tfsdk:"name_regex"`
+	Status    types.String        `tfsdk:"status"`
+	Labels    types.Map           `tfsdk:"labels"`
+	IDs       types.List          `tfsdk:"ids"`
+	VMs       []VMDataSourceModel `tfsdk:"vms"`
+}
+
+// Metadata возвращает метаданные data source
+func (d *VMsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vms"
+}
+
+// Schema определяет схему data source
+func (d *VMsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := filter.Attributes(true)
+	attrs["project_id"] = schema.StringAttribute{
+		MarkdownDescription: "Project ID (UUID)",
+		Required:            true,
+	}
+	attrs["ids"] = schema.ListAttribute{
+		MarkdownDescription: "IDs of the matching virtual machines",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	attrs["vms"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Matching virtual machines",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: vmDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists H3 Cloud virtual machines in a project, optionally filtered by name regex, status and labels",
+		Attributes:          attrs,
+	}
+}
+
+// Configure инициализирует data source с клиентом
+func (d *VMsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read получает все VM проекта и применяет фильтр
+func (d *VMsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config VMsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, diags := filter.New(ctx, config.NameRegex, config.Status, config.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listVMs(ctx, d.client, config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing virtual machines",
+			fmt.Sprintf("Could not list virtual machines: %s", err.Error()),
+		)
+		return
+	}
+
+	ids := []string{}
+	config.VMs = []VMDataSourceModel{}
+	for _, vm := range items {
+		if !f.Match(vm.Name, vm.Status, vm.Labels) {
+			continue
+		}
+
+		item := vmDataSourceModel(vm)
+
+		ids = append(ids, vm.ID)
+		config.VMs = append(config.VMs, item)
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}